@@ -0,0 +1,146 @@
+package friendlytime
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// dateOffset is one signed duration of a date math chain.
+type dateOffset struct {
+	sign     int
+	duration span
+}
+
+// tryParseDateMath handles an anchor followed by a chain of signed durations,
+// such as "2025-12-10+3d-2h", "yesterday+9h" or "now-1d". The anchor may be
+// any expression ParseTime accepts except date math itself; the offsets are
+// applied left to right with calendar-aware units.
+//
+// The chain is the longest run of valid offsets at the end of the string,
+// and the anchor is parsed once, so the work stays linear in the input.
+func (p *Parser) tryParseDateMath(timeStr string, now time.Time) (time.Time, bool, error) {
+	if p.inDateMath {
+		return time.Time{}, false, nil
+	}
+
+	anchorStr, offsets := p.cutOffsetChain(timeStr)
+	if len(offsets) == 0 || anchorStr == "" {
+		return time.Time{}, false, nil
+	}
+
+	anchorParser := *p
+	anchorParser.inDateMath = true
+
+	anchor, err := anchorParser.ParseTime(anchorStr, now, time.Time{})
+	if err != nil {
+		return time.Time{}, false, nil
+	}
+
+	for _, offset := range offsets {
+		anchor = offset.duration.addTo(anchor, offset.sign)
+	}
+
+	return anchor, true, nil
+}
+
+// tryParseRelativeTo handles "<duration> before|after|from <expression>",
+// such as "2 hours before 2025-12-10 15:00" or "3 days after last monday".
+// The expression may be anything ParseTime accepts, including another
+// relative expression: "1 hour after 2 days before yesterday".
+//
+// Nested durations are read left to right in one pass, and the innermost
+// expression is parsed once, so the work stays linear in the input.
+func (p *Parser) tryParseRelativeTo(timeStr string, now, startTime time.Time) (time.Time, bool, error) {
+	words := strings.Fields(strings.ToLower(timeStr))
+
+	var offsets []dateOffset
+
+	start := 0
+
+	// A direction needs an expression after it, so the last word is never one.
+	for i := 0; i < len(words)-1; i++ {
+		sign, ok := directionSign(words[i])
+		if !ok {
+			continue
+		}
+
+		duration, err := p.parseSpan(strings.Join(words[start:i], " "))
+		if err != nil {
+			break
+		}
+
+		offsets = append(offsets, dateOffset{sign: sign, duration: duration})
+		start = i + 1
+	}
+
+	if len(offsets) == 0 {
+		return time.Time{}, false, nil
+	}
+
+	anchor, err := p.ParseTime(strings.Join(words[start:], " "), now, startTime)
+	if err != nil {
+		return time.Time{}, true, fmt.Errorf("%w: %w", ErrInvalidTimeFormat, err)
+	}
+
+	// The innermost offset applies first.
+	for i := len(offsets) - 1; i >= 0; i-- {
+		anchor = offsets[i].duration.addTo(anchor, offsets[i].sign)
+	}
+
+	return anchor, true, nil
+}
+
+// directionSign returns the sign a duration followed by word is applied
+// with, if word is "before", "after" or "from".
+func directionSign(word string) (int, bool) {
+	switch word {
+	case "before":
+		return -1, true
+	case "after", "from":
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// cutOffsetChain splits timeStr into an anchor and the longest chain of
+// signed durations it ends with, such as "2025-12-10" and "+3d-2h". A sign
+// at the very start belongs to the anchor.
+func (p *Parser) cutOffsetChain(timeStr string) (string, []dateOffset) {
+	var offsets []dateOffset
+
+	end := len(timeStr)
+	for i := end - 1; i > 0; i-- {
+		if timeStr[i] != '+' && timeStr[i] != '-' {
+			continue
+		}
+
+		duration, err := p.parseCalendarSpan(timeStr[i+1 : end])
+		if err != nil {
+			break
+		}
+
+		sign := 1
+		if timeStr[i] == '-' {
+			sign = -1
+		}
+
+		offsets = append(offsets, dateOffset{sign: sign, duration: duration})
+		end = i
+	}
+
+	// The chain was read right to left.
+	slices.Reverse(offsets)
+
+	return strings.TrimSpace(timeStr[:end]), offsets
+}
+
+// parseCalendarSpan parses a duration expression with calendar units enabled.
+func (p *Parser) parseCalendarSpan(durationStr string) (span, error) {
+	calendar := *p
+	calendar.calendarUnits = true
+
+	return calendar.parseSpan(durationStr)
+}
@@ -0,0 +1,141 @@
+package friendlytime
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime_DateMath(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "2025-12-10+3d-2h", expected: time.Date(2025, 12, 12, 22, 0, 0, 0, time.UTC)},
+		{input: "2025-12-10 09:00+90m", expected: time.Date(2025, 12, 10, 10, 30, 0, 0, time.UTC)},
+		{input: "2025-12-10 09:00 + 90m", expected: time.Date(2025, 12, 10, 10, 30, 0, 0, time.UTC)},
+		{input: "yesterday+9h", expected: time.Date(2025, 12, 9, 9, 0, 0, 0, time.UTC)},
+		{input: "now-1d", expected: now.AddDate(0, 0, -1)},
+		{input: "now", expected: now},
+		{input: "last monday+1w-1h", expected: time.Date(2025, 12, 14, 23, 0, 0, 0, time.UTC)},
+		{input: "1416434697+30s", expected: time.Unix(1416434727, 0)},
+		{input: "2025-01-31+1mo", expected: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{input: "2024-02-29+1y", expected: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{input: "now+1 day 2 hours-30 minutes", expected: now.AddDate(0, 0, 1).Add(90 * time.Minute)},
+		{input: "15:00+1h", expected: time.Date(2025, 12, 10, 16, 0, 0, 0, time.UTC)},
+		{input: "the 15th+9h", expected: time.Date(2025, 12, 15, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+
+	t.Run("calendar-aware days across DST", func(t *testing.T) {
+		loc, err := time.LoadLocation("Europe/Berlin")
+		if err != nil {
+			t.Skip("time zone data unavailable")
+		}
+
+		result, err := NewParser(WithLocation(loc)).ParseTime("2025-03-29 12:00+1d", now, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 3, 30, 12, 0, 0, 0, loc), result)
+	})
+
+	for _, input := range []string{"yesterday+", "yesterday+9 parsecs", "nonsense+1h", "2025-12-10+"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := ParseTime(input, now, time.Time{})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+}
+
+func TestParseTimeRange_DateMath(t *testing.T) {
+	start, end, err := ParseTimeRange("2025-12-10+9h/2025-12-10+17h")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC).Unix(), start)
+	assert.Equal(t, time.Date(2025, 12, 10, 17, 0, 0, 0, time.UTC).Unix(), end)
+}
+
+func TestParseTime_DateMathLongInvalidChain(t *testing.T) {
+	now := fixedTime()
+	chain := strings.Repeat("-1d", 200)
+
+	started := time.Now()
+
+	_, err := ParseTime("x"+chain, now, time.Time{})
+	require.Error(t, err)
+
+	_, err = ParseRange("x" + strings.Repeat("+1h", 200) + "/now")
+	require.Error(t, err)
+
+	assert.Less(t, time.Since(started), time.Second)
+
+	result, err := ParseTime("now"+chain, now, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, now.AddDate(0, 0, -200), result)
+}
+
+func TestParseTime_LongChainsStayLinear(t *testing.T) {
+	now := fixedTime()
+	started := time.Now()
+
+	result, err := ParseTime("now"+strings.Repeat("-1s", 40000), now, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-40000*time.Second), result)
+
+	result, err = ParseTime(strings.Repeat("1 second after ", 4000)+"now", now, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(4000*time.Second), result)
+
+	assert.Less(t, time.Since(started), time.Second)
+}
+
+func TestParseTime_RelativeToExpression(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	startTime := now.Add(-2 * time.Hour)
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "2 hours before 2025-12-10 15:00", expected: time.Date(2025, 12, 10, 13, 0, 0, 0, time.UTC)},
+		{input: "3 days after last monday", expected: midnight(2025, 12, 11)},
+		{input: "2 days from yesterday", expected: midnight(2025, 12, 11)},
+		{input: "2 hours from now", expected: now.Add(2 * time.Hour)},
+		{input: "1 hour after 2 days before yesterday", expected: time.Date(2025, 12, 7, 1, 0, 0, 0, time.UTC)},
+		{input: "30 minutes after 1 hour before 2 days after 2025-12-01", expected: time.Date(2025, 12, 2, 23, 30, 0, 0, time.UTC)},
+		{input: "a week before the 15th of next month", expected: midnight(2026, 1, 8)},
+		{input: "1 day 2 hours after 1416434697", expected: time.Unix(1416434697+26*3600, 0)},
+		{input: "5 minutes after +30m", expected: startTime.Add(35 * time.Minute)},
+		{input: "2 Hours Before 2025-12-10  15:00", expected: time.Date(2025, 12, 10, 13, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseTime(tt.input, now, startTime)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+
+	for _, input := range []string{"2 hours before nonsense", "2 hours after", "parsecs before yesterday"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := ParseTime(input, now, startTime)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+}
@@ -0,0 +1,466 @@
+package friendlytime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	daysPerWeek   = 7
+	yearDigits    = 4
+	maxDayOfMonth = 31
+
+	// Numeric dates and times: "YYYY-MM-DD" or "YY-MM-DD", and
+	// "HH:MM[:SS[.fraction]]". Two-digit years 69-99 are in the 1900s and
+	// 00-68 in the 2000s, as with %y.
+	numericDateParts = 3
+	clockParts       = 3
+	fieldDigits      = 2 // digits of a month, day, hour, minute or second
+	fractionDigits   = 9 // digits of a nanosecond fraction of a second
+	shortYearPivot   = 1969
+	shortYearCentury = 1900
+
+	// Weekday-of-month expressions: "first".."fifth", or "last".
+	weekdayOccurrenceFields = 2
+	maxWeekdayOccurrence    = 5
+	lastOccurrence          = -1
+)
+
+// getMonthNames returns month names and abbreviations, indexed by month - 1.
+func getMonthNames() [][]string {
+	return [][]string{
+		{"january", "jan"},
+		{"february", "feb"},
+		{"march", "mar"},
+		{"april", "apr"},
+		{"may"},
+		{"june", "jun"},
+		{"july", "jul"},
+		{"august", "aug"},
+		{"september", "sept", "sep"},
+		{"october", "oct"},
+		{"november", "nov"},
+		{"december", "dec"},
+	}
+}
+
+// getOrdinalWords returns spelled-out ordinals, indexed by value.
+func getOrdinalWords() []string {
+	return []string{
+		"", "first", "second", "third", "fourth", "fifth",
+		"sixth", "seventh", "eighth", "ninth", "tenth",
+	}
+}
+
+// parseMonth parses a month name or abbreviation.
+func parseMonth(name string) (time.Month, bool) {
+	for index, names := range getMonthNames() {
+		for _, monthName := range names {
+			if monthName == name {
+				return time.Month(index + 1), true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// parseOrdinal parses an ordinal such as "first", "2nd" or "15th".
+func parseOrdinal(word string) (int, bool) {
+	for value, name := range getOrdinalWords() {
+		if name != "" && name == word {
+			return value, true
+		}
+	}
+
+	for _, suffix := range []string{"st", "nd", "rd", "th"} {
+		digits, ok := strings.CutSuffix(word, suffix)
+		if !ok {
+			continue
+		}
+
+		value, err := strconv.Atoi(digits)
+		if err != nil || value <= 0 || digits[0] == '+' {
+			return 0, false
+		}
+
+		return value, true
+	}
+
+	return 0, false
+}
+
+// tryParseCalendarFormats handles expressions anchored to a calendar month or
+// week: weekdays of a month ("first monday of next month"), days of a month
+// ("the 15th", "15th of last month", "last day of the month") and weekdays of
+// a week ("friday last week"), and bare weekdays ("friday").
+func (p *Parser) tryParseCalendarFormats(timeStr string, now time.Time) (time.Time, bool, error) {
+	lowerTimeStr := strings.Join(strings.Fields(strings.ToLower(timeStr)), " ")
+	lowerTimeStr = strings.TrimPrefix(lowerTimeStr, "on ")
+
+	if t, ok := p.parseWeekdayOfWeek(lowerTimeStr, now); ok {
+		return t, true, nil
+	}
+
+	if weekday, err := parseWeekday(lowerTimeStr); err == nil {
+		return p.resolveWeekday(weekday, now), true, nil
+	}
+
+	return p.parseDayOfMonth(lowerTimeStr, now)
+}
+
+// resolveWeekday resolves a bare weekday to its midnight: in the current week,
+// or on either side of today depending on the parser's preference.
+func (p *Parser) resolveWeekday(weekday time.Weekday, now time.Time) time.Time {
+	daysIntoWeek := (int(weekday) - int(p.weekStart()) + daysPerWeek) % daysPerWeek
+	weekStart := p.startOfWeek(now)
+
+	return p.preferred(func(n int) time.Time {
+		return weekStart.AddDate(0, 0, daysIntoWeek+n*daysPerWeek)
+	}, getMidnight(now))
+}
+
+// parseDayOfMonth resolves a day of a month to its midnight. Days past the end
+// of the month overflow into the next one, as with time.Time.AddDate. A bare
+// day ("the 15th") is in the current month unless the parser prefers another.
+func (p *Parser) parseDayOfMonth(timeStr string, now time.Time) (time.Time, bool, error) {
+	dayStr, monthStr, found := strings.Cut(timeStr, " of ")
+	if !found {
+		day, ok := parseBareDayOrdinal(timeStr)
+		if !ok {
+			return time.Time{}, false, nil
+		}
+
+		return p.preferred(func(n int) time.Time {
+			return nthMonthWithDay(now, day, n)
+		}, getMidnight(now)), true, nil
+	}
+
+	if fields := strings.Fields(dayStr); len(fields) == weekdayOccurrenceFields {
+		occurrence, isOccurrence := parseOccurrence(fields[0])
+		weekday, err := parseWeekday(fields[1])
+
+		if isOccurrence && err == nil {
+			year, month, err := parseMonthReference(monthStr, now)
+			if err != nil {
+				return time.Time{}, true, err
+			}
+
+			t, err := nthWeekdayOfMonth(year, month, weekday, occurrence, now.Location())
+
+			return t, true, err
+		}
+	}
+
+	day, ok := parseDayOrdinal(strings.TrimPrefix(dayStr, "the "))
+	if !ok {
+		return time.Time{}, false, nil
+	}
+
+	year, month, err := parseMonthReference(monthStr, now)
+	if err != nil {
+		return time.Time{}, true, err
+	}
+
+	if day == lastOccurrence {
+		day = daysIn(year, month)
+	}
+
+	return time.Date(year, month, day, 0, 0, 0, 0, now.Location()), true, nil
+}
+
+// nthMonthWithDay returns the given day of the n-th month that has it,
+// counting from the month of now. Months too short for the day are skipped
+// rather than rolled over, so the 31st after November 30 is December 31.
+// For n = 0 it is the month of now, or the next month if that one is too
+// short.
+func nthMonthWithDay(now time.Time, day, n int) time.Time {
+	offset := 0
+
+	hasDay := func() bool {
+		first := time.Date(now.Year(), now.Month()+time.Month(offset), 1, 0, 0, 0, 0, time.UTC)
+
+		return daysIn(first.Year(), first.Month()) >= day
+	}
+
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	} else {
+		for !hasDay() {
+			offset++
+		}
+	}
+
+	for ; n > 0; n-- {
+		offset += step
+		for !hasDay() {
+			offset += step
+		}
+	}
+
+	return time.Date(now.Year(), now.Month()+time.Month(offset), day, 0, 0, 0, 0, now.Location())
+}
+
+// parseDayOrdinal parses the day part of "<day> of <month>": an ordinal from
+// 1 to 31, "first day", "start" or "beginning" for the first day, and
+// "last day" or "end" for the last day (returned as lastOccurrence).
+func parseDayOrdinal(dayStr string) (int, bool) {
+	switch dayStr {
+	case "first day", "start", "beginning":
+		return 1, true
+	case "last day", "end":
+		return lastOccurrence, true
+	}
+
+	day, ok := parseOrdinal(dayStr)
+	if !ok || day > maxDayOfMonth {
+		return 0, false
+	}
+
+	return day, true
+}
+
+// parseBareDayOrdinal parses a day of the current month written without a
+// month: "the 15th", "15th" or "the first".
+func parseBareDayOrdinal(dayStr string) (int, bool) {
+	trimmed, hasArticle := strings.CutPrefix(dayStr, "the ")
+	if !hasArticle && (trimmed == "" || trimmed[0] < '0' || trimmed[0] > '9') {
+		return 0, false
+	}
+
+	day, ok := parseOrdinal(trimmed)
+	if !ok || day > maxDayOfMonth {
+		return 0, false
+	}
+
+	return day, true
+}
+
+// parseOccurrence parses the ordinal of a weekday-of-month expression: "first" to "fifth", or "last".
+func parseOccurrence(word string) (int, bool) {
+	if word == "last" {
+		return lastOccurrence, true
+	}
+
+	occurrence, ok := parseOrdinal(word)
+	if !ok || occurrence > maxWeekdayOccurrence {
+		return 0, false
+	}
+
+	return occurrence, true
+}
+
+// parseMonthReference resolves "the month", "this/last/next month" or a month
+// name with an optional year ("december", "december 2025") to a year and month.
+// A month name without a year refers to the current year.
+func parseMonthReference(monthStr string, now time.Time) (int, time.Month, error) {
+	monthStr = strings.Join(strings.Fields(monthStr), " ")
+
+	offset := 0
+
+	switch monthStr {
+	case "month", "the month", "this month", "the current month", "the same month":
+	case "last month", "the last month", "previous month", "the previous month":
+		offset = -1
+	case "next month", "the next month", "the following month":
+		offset = 1
+	default:
+		return parseNamedMonth(monthStr, now)
+	}
+
+	first := time.Date(now.Year(), now.Month()+time.Month(offset), 1, 0, 0, 0, 0, now.Location())
+
+	return first.Year(), first.Month(), nil
+}
+
+// parseNamedMonth parses a month name with an optional four-digit year.
+func parseNamedMonth(monthStr string, now time.Time) (int, time.Month, error) {
+	fields := strings.Fields(monthStr)
+	if len(fields) == 0 || len(fields) > 2 {
+		return 0, 0, fmt.Errorf("%w: unknown month %q", ErrInvalidTimeFormat, monthStr)
+	}
+
+	month, ok := parseMonth(fields[0])
+	if !ok {
+		return 0, 0, fmt.Errorf("%w: unknown month %q", ErrInvalidTimeFormat, monthStr)
+	}
+
+	if len(fields) == 1 {
+		return now.Year(), month, nil
+	}
+
+	year, err := strconv.Atoi(fields[1])
+	if err != nil || len(fields[1]) != yearDigits {
+		return 0, 0, fmt.Errorf("%w: invalid year %q", ErrInvalidTimeFormat, fields[1])
+	}
+
+	return year, month, nil
+}
+
+// nthWeekdayOfMonth returns midnight of the given occurrence of weekday in the month.
+// An occurrence of lastOccurrence selects the last such weekday.
+func nthWeekdayOfMonth(
+	year int,
+	month time.Month,
+	weekday time.Weekday,
+	occurrence int,
+	loc *time.Location,
+) (time.Time, error) {
+	daysInMonth := daysIn(year, month)
+
+	var day int
+
+	if occurrence == lastOccurrence {
+		lastWeekday := time.Date(year, month, daysInMonth, 0, 0, 0, 0, loc).Weekday()
+		day = daysInMonth - (int(lastWeekday)-int(weekday)+daysPerWeek)%daysPerWeek
+	} else {
+		firstWeekday := time.Date(year, month, 1, 0, 0, 0, 0, loc).Weekday()
+		day = 1 + (int(weekday)-int(firstWeekday)+daysPerWeek)%daysPerWeek + (occurrence-1)*daysPerWeek
+	}
+
+	if day > daysInMonth {
+		return time.Time{}, fmt.Errorf(
+			"%w: %w: %s %d has no %s %s",
+			ErrInvalidTimeFormat,
+			ErrNonexistentDate,
+			month,
+			year,
+			getOrdinalWords()[occurrence],
+			weekday,
+		)
+	}
+
+	return time.Date(year, month, day, 0, 0, 0, 0, loc), nil
+}
+
+// daysIn returns the number of days in the month.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// parseNumericDate parses "YYYY-MM-DD" or "YY-MM-DD".
+func parseNumericDate(s string) (int, time.Month, int, bool) {
+	parts := strings.Split(s, "-")
+	if len(parts) != numericDateParts {
+		return 0, 0, 0, false
+	}
+
+	year, ok := parseDigits(parts[0], shortYearDigits, yearDigits)
+	if !ok || (len(parts[0]) != shortYearDigits && len(parts[0]) != yearDigits) {
+		return 0, 0, 0, false
+	}
+
+	if len(parts[0]) == shortYearDigits {
+		year += shortYearCentury
+		if year < shortYearPivot {
+			year += yearsPerCentury
+		}
+	}
+
+	month, okMonth := parseDigits(parts[1], 1, fieldDigits)
+	day, okDay := parseDigits(parts[2], 1, fieldDigits)
+
+	if !okMonth || !okDay || month < 1 || month > monthsPerYear || day < 1 {
+		return 0, 0, 0, false
+	}
+
+	return year, time.Month(month), day, true
+}
+
+// parseClock parses "HH:MM[:SS[.fraction]]", optionally followed by
+// "Z" or a numeric offset such as "+02:00". The returned zone is nil when
+// the clock has none.
+func parseClock(s string) (int, int, int, int, *time.Location, bool) {
+	var zone *time.Location
+
+	if rest, ok := strings.CutSuffix(s, "Z"); ok {
+		s, zone = rest, time.UTC
+	} else if i := strings.LastIndexAny(s, "+-"); i > 0 {
+		offset, ok := parseNumericOffset(s[i:])
+		if !ok {
+			return 0, 0, 0, 0, nil, false
+		}
+
+		s, zone = s[:i], offset
+	}
+
+	clock, fraction, hasFraction := strings.Cut(s, ".")
+	parts := strings.Split(clock, ":")
+
+	if len(parts) < clockParts-1 || len(parts) > clockParts ||
+		(hasFraction && len(parts) != clockParts) {
+		return 0, 0, 0, 0, nil, false
+	}
+
+	hour, okHour := parseDigits(parts[0], 1, fieldDigits)
+	minute, okMinute := parseDigits(parts[1], fieldDigits, fieldDigits)
+	sec, okSec := 0, true
+
+	if len(parts) == clockParts {
+		sec, okSec = parseDigits(parts[2], fieldDigits, fieldDigits)
+	}
+
+	if !okHour || !okMinute || !okSec || hour >= hoursPerDay || minute >= minutesPerHour || sec >= secondsPerMinute {
+		return 0, 0, 0, 0, nil, false
+	}
+
+	nsec := 0
+
+	if hasFraction {
+		digits, ok := parseDigits(fraction, 1, fractionDigits)
+		if !ok {
+			return 0, 0, 0, 0, nil, false
+		}
+
+		nsec = digits
+		for range fractionDigits - len(fraction) {
+			nsec *= tensStep
+		}
+	}
+
+	return hour, minute, sec, nsec, zone, true
+}
+
+// parseNumericOffset parses a numeric UTC offset: "+02:00", "+0200" or "+02".
+func parseNumericOffset(s string) (*time.Location, bool) {
+	sign := 1
+	if s[0] == '-' {
+		sign = -1
+	}
+
+	digits := strings.ReplaceAll(s[1:], ":", "")
+
+	hours, ok := parseDigits(digits[:min(len(digits), fieldDigits)], fieldDigits, fieldDigits)
+	if !ok {
+		return nil, false
+	}
+
+	minutes := 0
+
+	if len(digits) > fieldDigits {
+		minutes, ok = parseDigits(digits[fieldDigits:], fieldDigits, fieldDigits)
+		if !ok {
+			return nil, false
+		}
+	}
+
+	if hours >= hoursPerDay || minutes >= minutesPerHour {
+		return nil, false
+	}
+
+	return time.FixedZone("", sign*(hours*secondsPerHour+minutes*secondsPerMinute)), true
+}
+
+// parseDigits parses a run of minLen to maxLen ASCII digits.
+func parseDigits(s string, minLen, maxLen int) (int, bool) {
+	if len(s) < minLen || len(s) > maxLen || !isAllDigits(s) {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(s)
+
+	return n, err == nil
+}
@@ -0,0 +1,180 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime_WeekdayOfMonth(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "first monday of next month", expected: midnight(2026, 1, 5)},
+		{input: "second tuesday of the month", expected: midnight(2025, 12, 9)},
+		{input: "2nd tuesday of this month", expected: midnight(2025, 12, 9)},
+		{input: "last friday of the month", expected: midnight(2025, 12, 26)},
+		{input: "last friday of december", expected: midnight(2025, 12, 26)},
+		{input: "last wednesday of december", expected: midnight(2025, 12, 31)},
+		{input: "first sunday of last month", expected: midnight(2025, 11, 2)},
+		{input: "third thursday of november 2024", expected: midnight(2024, 11, 21)},
+		{input: "fifth monday of september 2025", expected: midnight(2025, 9, 29)},
+		{input: "1st wednesday of jan", expected: midnight(2025, 1, 1)},
+		{input: "Last Saturday Of February 2024", expected: midnight(2024, 2, 24)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestParseTime_WeekdayOfMonthErrors(t *testing.T) {
+	now := fixedTime()
+
+	t.Run("nonexistent occurrence", func(t *testing.T) {
+		_, err := ParseTime("fifth monday of november 2025", now, time.Time{})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrNonexistentDate))
+		assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		assert.Contains(t, err.Error(), "November 2025 has no fifth Monday")
+	})
+
+	for _, input := range []string{
+		"first monday of smarch",
+		"first monday of december 25",
+		"sixth monday of the month",
+		"first funday of the month",
+	} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseTime(input, now, time.Time{})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+}
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	// February 2026 starts on a Sunday and has exactly four of every weekday.
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		first, err := nthWeekdayOfMonth(2026, time.February, weekday, 1, time.UTC)
+		require.NoError(t, err)
+		assert.Equal(t, 1+int(weekday), first.Day())
+
+		last, err := nthWeekdayOfMonth(2026, time.February, weekday, lastOccurrence, time.UTC)
+		require.NoError(t, err)
+		assert.Equal(t, 22+int(weekday), last.Day())
+
+		_, err = nthWeekdayOfMonth(2026, time.February, weekday, 5, time.UTC)
+		assert.True(t, errors.Is(err, ErrNonexistentDate))
+	}
+}
+
+func TestParseOrdinal(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+		ok       bool
+	}{
+		{input: "first", expected: 1, ok: true},
+		{input: "fifth", expected: 5, ok: true},
+		{input: "1st", expected: 1, ok: true},
+		{input: "2nd", expected: 2, ok: true},
+		{input: "3rd", expected: 3, ok: true},
+		{input: "15th", expected: 15, ok: true},
+		{input: "0th"},
+		{input: "th"},
+		{input: "+1st"},
+		{input: "monday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, ok := parseOrdinal(tt.input)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestParseTime_DayOfMonth(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "the 15th", expected: midnight(2025, 12, 15)},
+		{input: "on the 15th", expected: midnight(2025, 12, 15)},
+		{input: "15th", expected: midnight(2025, 12, 15)},
+		{input: "the first", expected: midnight(2025, 12, 1)},
+		{input: "15th of last month", expected: midnight(2025, 11, 15)},
+		{input: "the 1st of next month", expected: midnight(2026, 1, 1)},
+		{input: "the 3rd of february 2024", expected: midnight(2024, 2, 3)},
+		{input: "last day of the month", expected: midnight(2025, 12, 31)},
+		{input: "last day of february 2024", expected: midnight(2024, 2, 29)},
+		{input: "first day of next month", expected: midnight(2026, 1, 1)},
+		{input: "end of last month", expected: midnight(2025, 11, 30)},
+		{input: "start of the month", expected: midnight(2025, 12, 1)},
+		{input: "beginning of month", expected: midnight(2025, 12, 1)},
+		{input: "3 days before the end of the month", expected: midnight(2025, 12, 28)},
+		{input: "3 days before end of month", expected: midnight(2025, 12, 28)},
+		{input: "2 days after the start of next month", expected: midnight(2026, 1, 3)},
+		{input: "12 hours after the 15th", expected: time.Date(2025, 12, 15, 12, 0, 0, 0, time.UTC)},
+		{input: "31st of november", expected: midnight(2025, 12, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	for _, input := range []string{"the 32nd", "first", "15th of smarch", "3 parsecs before the end of the month"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := ParseTime(input, now, time.Time{})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+}
+
+func TestParser_WithLocation(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+	parser := NewParser(WithLocation(tokyo))
+
+	// 20:00 UTC on December 10 is already December 11 in Tokyo.
+	now := time.Date(2025, 12, 10, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "the 15th", expected: time.Date(2025, 12, 15, 0, 0, 0, 0, tokyo)},
+		{input: "last day of the month", expected: time.Date(2025, 12, 31, 0, 0, 0, 0, tokyo)},
+		{input: "yesterday", expected: time.Date(2025, 12, 10, 0, 0, 0, 0, tokyo)},
+		{input: "2025-12-10", expected: time.Date(2025, 12, 10, 0, 0, 0, 0, tokyo)},
+		{input: "09:00", expected: time.Date(2025, 12, 11, 9, 0, 0, 0, tokyo)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := parser.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
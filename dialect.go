@@ -0,0 +1,369 @@
+package friendlytime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Dialect selects the grammar a Parser accepts. Dialects other than
+// DefaultDialect follow another tool's time syntax exactly, instead of this
+// package's own grammar.
+type Dialect int
+
+const (
+	// DefaultDialect is this package's own grammar.
+	DefaultDialect Dialect = iota
+	// GrafanaDialect parses Grafana time range expressions such as "now-6h",
+	// "now/d" and "now-1d/d". See parseGrafana.
+	GrafanaDialect
+	// ElasticsearchDialect parses Elasticsearch date math such as "now-1d/d"
+	// and "2025-12-10||+1M/d". See parseElasticsearch.
+	ElasticsearchDialect
+	// SplunkDialect parses Splunk time modifiers such as "-24h@h" and
+	// "@w1", and "earliest=-7d@d latest=now" pairs. See parseSplunk.
+	SplunkDialect
+	// GraphiteDialect parses Graphite from/until values such as "-1d",
+	// "noon tomorrow" and "15:30_20251210", and "from=-1d&until=now"
+	// pairs. See parseGraphite.
+	GraphiteDialect
+	// SystemdDialect parses systemd time specifications such as "yesterday",
+	// "-5min", "@1416434697" and "Fri 2012-11-23 11:12", as accepted by
+	// journalctl --since. See parseSystemd.
+	SystemdDialect
+	// GNUDialect parses the relative items of GNU date -d and git's
+	// approxidate, such as "2.weeks.ago", "last friday 5pm" and
+	// "1 month ago noon". See parseGNU.
+	GNUDialect
+	// PostgresDialect parses PostgreSQL timestamp literals and interval
+	// input such as "1 day 02:00:00" and "@ 3 days ago", "infinity" and
+	// "-infinity", and range literals such as "[2025-12-01,2025-12-10)".
+	// See parsePostgres.
+	PostgresDialect
+	// PrometheusDialect parses Prometheus API timestamps: RFC 3339 or Unix
+	// seconds such as "1416434697.123". See ParsePrometheusRange for range
+	// queries with a step.
+	PrometheusDialect
+	// InfluxQLDialect parses InfluxQL time expressions such as
+	// "now() - 2d + 30m", and "time >= ... AND time < ..." conditions. See
+	// parseInfluxQL.
+	InfluxQLDialect
+	// FluxDialect parses Flux times such as "-1h", "-1mo2w" and
+	// "2025-12-10T15:00:00Z", and range() parameters. See parseFlux.
+	FluxDialect
+)
+
+// ParseBounds parses the two sides of a time range given separately, as
+// from/to pairs usually are in query strings and dashboards. With the
+// default dialect each side is parsed like a side of ParseRange; other
+// dialects parse each side with their own grammar and round the end up
+// where the dialect does, as for an Elasticsearch gte/lte pair.
+//
+// ParseBounds uses the default options; see Parser for configurable parsing.
+func ParseBounds(from, to string) (Range, error) {
+	return defaultParser.ParseBounds(from, to)
+}
+
+// ParseBounds parses a from/to pair like the package-level ParseBounds,
+// using the parser's options.
+func (p *Parser) ParseBounds(from, to string) (Range, error) {
+	return p.parseBoundsAt(from, to, p.localize(p.clock()))
+}
+
+// parseBoundsAt parses a from/to pair relative to now. With the default
+// dialect the pair is read like the two sides of ParseRange, so either side
+// may be an ISO 8601 duration.
+func (p *Parser) parseBoundsAt(from, to string, now time.Time) (Range, error) {
+	if p.dialect == DefaultDialect {
+		return p.parseRangeSides(from, to, now)
+	}
+
+	start, err := p.parseDialectBound(from, now, time.Time{}, false)
+	if err != nil {
+		return Range{}, fmt.Errorf("%w: %w", ErrInvalidStartTime, err)
+	}
+
+	end, err := p.parseDialectBound(to, now, start, true)
+	if err != nil {
+		return Range{}, fmt.Errorf("%w: %w", ErrInvalidEndTime, err)
+	}
+
+	if !end.IsZero() && !start.IsZero() && end.Before(start) {
+		return Range{}, ErrEndBeforeStart
+	}
+
+	return Range{Start: start, End: end}, nil
+}
+
+// parseDialectBound parses one side of a range in the parser's dialect.
+func (p *Parser) parseDialectBound(timeStr string, now, startTime time.Time, isEnd bool) (time.Time, error) {
+	switch p.dialect {
+	case GrafanaDialect:
+		return p.parseGrafana(timeStr, now, isEnd)
+	case ElasticsearchDialect:
+		return p.parseElasticsearch(timeStr, now, isEnd)
+	case SplunkDialect:
+		return p.parseSplunk(timeStr, now, isEnd)
+	case GraphiteDialect:
+		return p.parseGraphite(timeStr, now, isEnd)
+	case SystemdDialect:
+		return p.parseSystemd(timeStr, now)
+	case GNUDialect:
+		return p.parseGNU(timeStr, now)
+	case PostgresDialect:
+		return p.parsePostgresBound(timeStr, now, isEnd)
+	case PrometheusDialect:
+		return p.parsePrometheus(timeStr)
+	case InfluxQLDialect:
+		return p.parseInfluxQL(timeStr, now)
+	case FluxDialect:
+		return p.parseFlux(timeStr, now, isEnd)
+	case DefaultDialect:
+	}
+
+	return p.parseBound(timeStr, now, startTime, isEnd)
+}
+
+// parseDialectRange parses a whole range string in a dialect other than the
+// default one. A single expression is an instant, unless the dialect has its
+// own way of writing a pair.
+func (p *Parser) parseDialectRange(timeRange string, now time.Time) (Range, error) {
+	switch p.dialect {
+	case SplunkDialect:
+		if r, ok, err := p.parseSplunkPair(timeRange, now); ok {
+			return r, err
+		}
+	case GraphiteDialect:
+		if r, ok, err := p.parseGraphitePair(timeRange, now); ok {
+			return r, err
+		}
+	case PostgresDialect:
+		if r, ok, err := p.parsePostgresRange(timeRange, now); ok {
+			return r, err
+		}
+	case InfluxQLDialect:
+		if r, ok, err := p.parseInfluxQLRange(timeRange, now); ok {
+			return r, err
+		}
+	case FluxDialect:
+		if r, ok, err := p.parseFluxRange(timeRange, now); ok {
+			return r, err
+		}
+	case DefaultDialect, GrafanaDialect, ElasticsearchDialect, SystemdDialect, GNUDialect, PrometheusDialect:
+	}
+
+	t, err := p.parseDialectTime(timeRange, now, time.Time{})
+	if err != nil {
+		return Range{}, err
+	}
+
+	return Range{Start: t, End: t}, nil
+}
+
+// parseDialectTime parses a single expression in a dialect other than the
+// default one, as ParseTime does. It is not a side of a range, so it rounds
+// up only with WithRoundUp, and PostgreSQL's infinities are reported with
+// ErrInfinity and ErrNegativeInfinity rather than as open ends.
+func (p *Parser) parseDialectTime(timeStr string, now, startTime time.Time) (time.Time, error) {
+	if p.dialect == PostgresDialect {
+		return p.parsePostgres(timeStr, now)
+	}
+
+	return p.parseDialectBound(timeStr, now, startTime, p.roundUp)
+}
+
+// addMonthsClamped adds months to t, clamping the day to the end of the
+// target month instead of overflowing into the next one: one month after
+// January 31 is February 28. This is how the date libraries behind most
+// dialects do month arithmetic.
+func addMonthsClamped(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	day := min(t.Day(), daysIn(first.Year(), first.Month()))
+
+	return time.Date(first.Year(), first.Month(), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// Units of dialect date math, named as Grafana and Elasticsearch name them.
+const (
+	unitYear    = 'y'
+	unitQuarter = 'Q'
+	unitMonth   = 'M'
+	unitWeek    = 'w'
+	unitDay     = 'd'
+	unitHour    = 'h'
+	unitMinute  = 'm'
+	unitSecond  = 's'
+)
+
+// startOfUnit rounds t down to the start of the unit it is in. Weeks start
+// on weekStart.
+func startOfUnit(t time.Time, unit byte, weekStart time.Weekday) time.Time {
+	year, month, day := t.Date()
+	loc := t.Location()
+
+	switch unit {
+	case unitYear:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	case unitQuarter:
+		return periodStart(t, monthsPerQuarter)
+	case unitMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	case unitWeek:
+		daysSinceStart := (int(t.Weekday()) - int(weekStart) + daysPerWeek) % daysPerWeek
+
+		return time.Date(year, month, day-daysSinceStart, 0, 0, 0, 0, loc)
+	case unitDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	case unitHour:
+		return time.Date(year, month, day, t.Hour(), 0, 0, 0, loc)
+	case unitMinute:
+		return time.Date(year, month, day, t.Hour(), t.Minute(), 0, 0, loc)
+	default:
+		return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), 0, loc)
+	}
+}
+
+// endOfUnit rounds t up to the last millisecond of the unit it is in, the
+// way Grafana and Elasticsearch round the end of a range.
+func endOfUnit(t time.Time, unit byte, weekStart time.Weekday) time.Time {
+	return addUnits(startOfUnit(t, unit, weekStart), 1, unit).Add(-time.Millisecond)
+}
+
+// addUnits adds n units to t. Years, quarters and months clamp the day to the
+// end of the target month; days and weeks keep the wall clock time; hours,
+// minutes and seconds are exact.
+func addUnits(t time.Time, n int, unit byte) time.Time {
+	switch unit {
+	case unitYear:
+		return addMonthsClamped(t, n*monthsPerYear)
+	case unitQuarter:
+		return addMonthsClamped(t, n*monthsPerQuarter)
+	case unitMonth:
+		return addMonthsClamped(t, n)
+	case unitWeek:
+		return t.AddDate(0, 0, n*daysPerWeek)
+	case unitDay:
+		return t.AddDate(0, 0, n)
+	case unitHour:
+		return t.Add(time.Duration(n) * time.Hour)
+	case unitMinute:
+		return t.Add(time.Duration(n) * time.Minute)
+	default:
+		return t.Add(time.Duration(n) * time.Second)
+	}
+}
+
+// dateMathSyntax describes the date math of a dialect: a chain of "+<n><unit>",
+// "-<n><unit>" and "/<unit>" operations, as in "now-1d/d".
+type dateMathSyntax struct {
+	units        string       // accepted unit letters
+	fiscal       bool         // "/fy" and "/fQ" round to fiscal years and quarters
+	maxNumberEnd int          // index in the chain past which amounts are rejected, or 0 for no limit
+	weekStart    time.Weekday // first day of the week for "/w"
+}
+
+// dateMathOperation is one step of a date math chain: "+<n><unit>",
+// "-<n><unit>" or "/<unit>".
+type dateMathOperation struct {
+	operation byte
+	num       int
+	unit      byte
+	fiscal    bool
+}
+
+// applyDateMath applies a chain of date math operations to t. Rounding goes
+// to the start of the unit, or to its last millisecond when roundUp is set.
+func (p *Parser) applyDateMath(mathStr string, t time.Time, roundUp bool, syntax dateMathSyntax) (time.Time, bool) {
+	for rest := mathStr; rest != ""; {
+		op, next, ok := parseDateMathOperation(rest, len(mathStr)-len(rest), syntax)
+		if !ok {
+			return time.Time{}, false
+		}
+
+		t = p.applyDateMathOperation(t, op, roundUp, syntax.weekStart)
+		rest = next
+	}
+
+	return t, true
+}
+
+// parseDateMathOperation parses the date math operation mathStr starts with,
+// returning it and the rest of the chain. offset is the index of mathStr in
+// the whole chain, which the amount limit applies to.
+func parseDateMathOperation(mathStr string, offset int, syntax dateMathSyntax) (dateMathOperation, string, bool) {
+	op := dateMathOperation{operation: mathStr[0], num: 1}
+	i := 1
+
+	if op.operation != '/' && op.operation != '+' && op.operation != '-' {
+		return dateMathOperation{}, "", false
+	}
+
+	if i < len(mathStr) && isDigit(mathStr[i]) {
+		start := i
+		for i < len(mathStr) && isDigit(mathStr[i]) {
+			i++
+			if syntax.maxNumberEnd > 0 && offset+i > syntax.maxNumberEnd {
+				return dateMathOperation{}, "", false
+			}
+		}
+
+		var err error
+
+		op.num, err = strconv.Atoi(mathStr[start:i])
+		if err != nil {
+			return dateMathOperation{}, "", false
+		}
+	}
+
+	// Rounding takes no amount.
+	if (op.operation == '/' && op.num != 1) || i >= len(mathStr) {
+		return dateMathOperation{}, "", false
+	}
+
+	op.unit = mathStr[i]
+	i++
+
+	if syntax.fiscal && op.unit == 'f' && i < len(mathStr) {
+		op.unit = mathStr[i]
+		i++
+		op.fiscal = true
+	}
+
+	if strings.IndexByte(syntax.units, op.unit) < 0 {
+		return dateMathOperation{}, "", false
+	}
+
+	// Elasticsearch accepts "H" for hours as well as "h".
+	if op.unit == 'H' {
+		op.unit = unitHour
+	}
+
+	return op, mathStr[i:], true
+}
+
+// applyDateMathOperation applies one date math operation to t.
+func (p *Parser) applyDateMathOperation(
+	t time.Time,
+	op dateMathOperation,
+	roundUp bool,
+	weekStart time.Weekday,
+) time.Time {
+	switch {
+	case op.operation == '/' && op.fiscal:
+		return p.roundToFiscal(t, op.unit, roundUp)
+	case op.operation == '/' && roundUp:
+		return endOfUnit(t, op.unit, weekStart)
+	case op.operation == '/':
+		return startOfUnit(t, op.unit, weekStart)
+	case op.operation == '+':
+		return addUnits(t, op.num, op.unit)
+	default:
+		return addUnits(t, -op.num, op.unit)
+	}
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,301 @@
+package friendlytime
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// durationUnit defines a unit accepted in duration expressions.
+type durationUnit struct {
+	names  []string
+	length time.Duration // fixed length, used unless calendar units are enabled
+	months int           // calendar months per unit
+	days   int           // calendar days per unit
+}
+
+// durationTerm is a single amount/unit pair of a duration expression.
+type durationTerm struct {
+	amount *big.Rat
+	unit   durationUnit
+}
+
+// span is an amount of time split into calendar and exact parts.
+// Calendar parts are only set when calendar units are enabled.
+type span struct {
+	months int
+	days   int
+	exact  time.Duration
+}
+
+// getDurationUnits returns the units accepted in duration expressions.
+//
+// "m" always means minutes, as in Go duration syntax; months must be
+// written as "mo" or longer.
+func getDurationUnits() []durationUnit {
+	const (
+		week    = 7 * hoursPerDay * time.Hour
+		quarter = 3 * hoursPerMonth * time.Hour
+		decade  = 10 * hoursPerYear * time.Hour
+	)
+
+	return []durationUnit{
+		{names: []string{"nanoseconds", "nanosecond", "nsec", "ns"}, length: time.Nanosecond},
+		{
+			names:  []string{"microseconds", "microsecond", "usec", "us", "µs", "μs"},
+			length: time.Microsecond,
+		},
+		{names: []string{"milliseconds", "millisecond", "msec", "ms"}, length: time.Millisecond},
+		{names: []string{"seconds", "second", "secs", "sec", "s"}, length: time.Second},
+		{names: []string{"minutes", "minute", "mins", "min", "m"}, length: time.Minute},
+		{names: []string{"hours", "hour", "hrs", "hr", "h"}, length: time.Hour},
+		{names: []string{"days", "day", "d"}, length: hoursPerDay * time.Hour, days: 1},
+		{names: []string{"weeks", "week", "wks", "wk", "w"}, length: week, days: 7},
+		{names: []string{"fortnights", "fortnight"}, length: 2 * week, days: 14},
+		{names: []string{"months", "month", "mos", "mo"}, length: hoursPerMonth * time.Hour, months: 1},
+		{names: []string{"quarters", "quarter", "qtrs", "qtr"}, length: quarter, months: 3},
+		{names: []string{"years", "year", "yrs", "yr", "y"}, length: hoursPerYear * time.Hour, months: 12},
+		{names: []string{"decades", "decade"}, length: decade, months: 120},
+	}
+}
+
+// lookupDurationUnit finds the unit with the given name.
+func lookupDurationUnit(name string) (durationUnit, bool) {
+	for _, unit := range getDurationUnits() {
+		for _, unitName := range unit.names {
+			if unitName == name {
+				return unit, true
+			}
+		}
+	}
+
+	return durationUnit{}, false
+}
+
+// addTo adds the span to t, or subtracts it when sign is negative.
+func (s span) addTo(t time.Time, sign int) time.Time {
+	return t.AddDate(0, sign*s.months, sign*s.days).Add(time.Duration(sign) * s.exact)
+}
+
+// parseSpan parses a duration expression such as "1h30m", "1.5 days" or
+// "2 days, 3 hours and 4 minutes": a sequence of amount/unit pairs,
+// optionally separated by commas or "and". Amounts may be decimal.
+//
+// Amounts may also be spelled out; see parseAmountWords. ISO 8601 durations
+// such as "P1DT2H" are accepted too; see parseISODuration.
+//
+// With calendar units enabled, day-based units (days, weeks, fortnights) and
+// month-based units (months, quarters, years, decades) are kept as calendar
+// parts of the span; otherwise every unit has a fixed length.
+func (p *Parser) parseSpan(durationStr string) (span, error) {
+	durationStr = strings.ToLower(strings.TrimSpace(durationStr))
+
+	if looksLikeISODuration(durationStr) {
+		return parseISODuration(durationStr)
+	}
+
+	terms, err := p.parseDurationTerms(durationStr)
+	if err != nil {
+		return span{}, err
+	}
+
+	return sumTerms(terms, durationStr, p.calendarUnits)
+}
+
+// sumTerms adds up the terms of a duration expression. With calendar set,
+// day- and month-based units are kept as calendar parts of the span.
+func sumTerms(terms []durationTerm, durationStr string, calendar bool) (span, error) {
+	var result span
+
+	exact := new(big.Rat)
+
+	for _, term := range terms {
+		if !calendar || (term.unit.months == 0 && term.unit.days == 0) {
+			exact.Add(exact, ratDuration(term.amount, term.unit.length))
+
+			continue
+		}
+
+		if term.unit.months > 0 {
+			months := new(big.Rat).Mul(term.amount, big.NewRat(int64(term.unit.months), 1))
+			if !months.IsInt() {
+				return span{}, fmt.Errorf(
+					"%w: %w: %s %s is not a whole number of months",
+					ErrInvalidDuration,
+					ErrFractionalCalendarUnit,
+					term.amount.FloatString(2),
+					term.unit.names[0],
+				)
+			}
+
+			if !addInt(&result.months, months.Num()) {
+				return span{}, fmt.Errorf("%w: %q overflows", ErrInvalidDuration, durationStr)
+			}
+
+			continue
+		}
+
+		days := new(big.Rat).Mul(term.amount, big.NewRat(int64(term.unit.days), 1))
+		whole := new(big.Int).Quo(days.Num(), days.Denom())
+
+		if !addInt(&result.days, whole) {
+			return span{}, fmt.Errorf("%w: %q overflows", ErrInvalidDuration, durationStr)
+		}
+
+		fraction := new(big.Rat).Sub(days, new(big.Rat).SetInt(whole))
+		exact.Add(exact, ratDuration(fraction, hoursPerDay*time.Hour))
+	}
+
+	// Truncate to whole nanoseconds like time.ParseDuration does.
+	nanos := new(big.Int).Quo(exact.Num(), exact.Denom())
+	if !nanos.IsInt64() {
+		return span{}, fmt.Errorf("%w: %q overflows", ErrInvalidDuration, durationStr)
+	}
+
+	result.exact = time.Duration(nanos.Int64())
+
+	return result, nil
+}
+
+// ratDuration returns amount * length as an exact number of nanoseconds.
+func ratDuration(amount *big.Rat, length time.Duration) *big.Rat {
+	return new(big.Rat).Mul(amount, big.NewRat(int64(length), 1))
+}
+
+// addInt adds n to *dst, reporting false if the result does not fit in an int32.
+// The limit keeps the sum safe to pass to time.Time.AddDate.
+func addInt(dst *int, n *big.Int) bool {
+	sum := new(big.Int).Add(big.NewInt(int64(*dst)), n)
+	if sum.CmpAbs(big.NewInt(math.MaxInt32)) > 0 {
+		return false
+	}
+
+	*dst = int(sum.Int64())
+
+	return true
+}
+
+// parseDurationTerms splits a duration expression into amount/unit pairs.
+func (p *Parser) parseDurationTerms(durationStr string) ([]durationTerm, error) {
+	var terms []durationTerm
+
+	rest := durationStr
+	afterSeparator := false
+
+	for {
+		rest = strings.TrimLeft(rest, " \t,")
+		if rest == "" {
+			break
+		}
+
+		if word, tail := cutWord(rest); word == "and" {
+			if len(terms) == 0 || afterSeparator {
+				return nil, fmt.Errorf("%w: unexpected %q in %q", ErrInvalidDuration, word, durationStr)
+			}
+
+			rest = tail
+			afterSeparator = true
+
+			continue
+		}
+
+		term, tail, err := p.parseDurationTerm(rest)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", err, durationStr)
+		}
+
+		terms = append(terms, term)
+		rest = tail
+		afterSeparator = false
+	}
+
+	if len(terms) == 0 || afterSeparator {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidDuration, durationStr)
+	}
+
+	return terms, nil
+}
+
+// parseDurationTerm parses one amount/unit pair from the start of s and returns the remainder.
+func (p *Parser) parseDurationTerm(s string) (durationTerm, string, error) {
+	amount, rest, err := p.parseAmount(s)
+	if err != nil {
+		return durationTerm{}, "", err
+	}
+
+	name, rest := cutWord(strings.TrimLeft(rest, " \t"))
+	if name == "" {
+		return durationTerm{}, "", fmt.Errorf("%w: missing unit", ErrInvalidDuration)
+	}
+
+	unit, ok := lookupDurationUnit(name)
+	if !ok {
+		return durationTerm{}, "", fmt.Errorf("%w: unknown unit %q", ErrInvalidDuration, name)
+	}
+
+	return durationTerm{amount: amount, unit: unit}, rest, nil
+}
+
+// parseAmount parses the amount of a duration term, given as digits or words.
+func (p *Parser) parseAmount(s string) (*big.Rat, string, error) {
+	if amount, rest, ok := p.parseAmountWords(s); ok {
+		return amount, rest, nil
+	}
+
+	numberStr, rest := cutNumber(s)
+	if numberStr == "" {
+		return nil, "", fmt.Errorf("%w: missing amount", ErrInvalidDuration)
+	}
+
+	amount, ok := new(big.Rat).SetString(numberStr)
+	if !ok {
+		return nil, "", fmt.Errorf("%w: invalid amount %q", ErrInvalidDuration, numberStr)
+	}
+
+	return amount, rest, nil
+}
+
+// cutNumber splits s into its leading decimal number and the remainder.
+func cutNumber(s string) (string, string) {
+	end := 0
+	seenDigit := false
+	seenDot := false
+
+	for end < len(s) {
+		c := s[end]
+
+		switch {
+		case c >= '0' && c <= '9':
+			seenDigit = true
+		case c == '.' && !seenDot:
+			seenDot = true
+		default:
+			if !seenDigit {
+				return "", s
+			}
+
+			return s[:end], s[end:]
+		}
+
+		end++
+	}
+
+	if !seenDigit {
+		return "", s
+	}
+
+	return s, ""
+}
+
+// cutWord splits s into its leading run of letters and the remainder.
+func cutWord(s string) (string, string) {
+	end := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsLetter(r) })
+	if end == -1 {
+		return s, ""
+	}
+
+	return s[:end], s[end:]
+}
@@ -0,0 +1,365 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpan(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{name: "seconds", input: "30 seconds", expected: 30 * time.Second},
+		{name: "minutes", input: "15 minutes", expected: 15 * time.Minute},
+		{name: "hours", input: "2 hours", expected: 2 * time.Hour},
+		{name: "days to hours", input: "3 days", expected: 72 * time.Hour},
+		{name: "1 day to hours", input: "1 day", expected: 24 * time.Hour},
+		{name: "months to hours", input: "2 months", expected: 1440 * time.Hour},
+		{name: "years to hours", input: "1 year", expected: 8760 * time.Hour},
+		{name: "go duration", input: "1h30m", expected: 90 * time.Minute},
+		{name: "short units", input: "5d", expected: 120 * time.Hour},
+		{
+			name:     "day and hours",
+			input:    "1 day 2 hours",
+			expected: 26 * time.Hour,
+		},
+		{
+			name:     "year and months",
+			input:    "1 year 6 months",
+			expected: 8760*time.Hour + 6*720*time.Hour,
+		},
+		{
+			name:     "commas and and",
+			input:    "2 days, 3 hours and 4 minutes",
+			expected: 51*time.Hour + 4*time.Minute,
+		},
+		{
+			name:     "no spaces mixed units",
+			input:    "1d12h",
+			expected: 36 * time.Hour,
+		},
+		{
+			name:     "mixed spellings",
+			input:    "1 hour 30min 15s",
+			expected: time.Hour + 30*time.Minute + 15*time.Second,
+		},
+		{
+			name:     "upper case",
+			input:    "2 Hours",
+			expected: 2 * time.Hour,
+		},
+		{name: "fractional hours", input: "1.5h", expected: 90 * time.Minute},
+		{name: "fractional days", input: "1.5 days", expected: 36 * time.Hour},
+		{name: "fractional months", input: "0.5 months", expected: 360 * time.Hour},
+		{name: "fractional years", input: "0.5y", expected: 4380 * time.Hour},
+		{name: "leading dot", input: ".5 hours", expected: 30 * time.Minute},
+		{name: "fractional compound", input: "2.25 hours 0.5s", expected: 135*time.Minute + 500*time.Millisecond},
+		{name: "sub-nanosecond truncated", input: "1.9ns", expected: time.Nanosecond},
+		{name: "weekday", input: "last monday", wantErr: true},
+		{name: "double dot", input: "1.5.5h", wantErr: true},
+		{name: "lone dot", input: ". hours", wantErr: true},
+		{name: "missing unit", input: "5", wantErr: true},
+		{name: "missing amount", input: "hours", wantErr: true},
+		{name: "unknown unit", input: "5 parsecs", wantErr: true},
+		{name: "leading and", input: "and 5 hours", wantErr: true},
+		{name: "trailing and", input: "5 hours and", wantErr: true},
+		{name: "double and", input: "5 hours and and 2 minutes", wantErr: true},
+		{name: "overflow", input: "99999999999 years", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := defaultParser.parseSpan(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, ErrInvalidDuration))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, span{exact: tt.expected}, result)
+			}
+		})
+	}
+}
+
+func TestParseTime_CompoundDurations(t *testing.T) {
+	now := fixedTime()
+	startTime := now.Add(-2 * time.Hour)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Time
+	}{
+		{
+			name:     "ago",
+			input:    "1 day 2 hours ago",
+			expected: now.Add(-26 * time.Hour),
+		},
+		{
+			name:     "go duration ago",
+			input:    "1h30m ago",
+			expected: now.Add(-90 * time.Minute),
+		},
+		{
+			name:     "ago with commas and and",
+			input:    "1 day, 2 hours and 30 minutes ago",
+			expected: now.Add(-26*time.Hour - 30*time.Minute),
+		},
+		{
+			name:     "bare",
+			input:    "1 year 6 months",
+			expected: now.Add(-8760*time.Hour - 4320*time.Hour),
+		},
+		{
+			name:     "in future",
+			input:    "in 1 day 2 hours",
+			expected: now.Add(26 * time.Hour),
+		},
+		{
+			name:     "from now",
+			input:    "2 hours and 15 minutes from now",
+			expected: now.Add(2*time.Hour + 15*time.Minute),
+		},
+		{
+			name:     "positive prefix",
+			input:    "+1 hour 30 minutes",
+			expected: startTime.Add(90 * time.Minute),
+		},
+		{
+			name:     "negative prefix",
+			input:    "-1 day 1 hour",
+			expected: now.Add(-25 * time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseTime(tt.input, now, startTime)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestParseTime_InvalidCompoundDurations(t *testing.T) {
+	now := fixedTime()
+
+	for _, input := range []string{"1 day 2 ago", "in 5 parsecs", "+1 hour and", "1 day banana ago"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseTime(input, now, time.Time{})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+}
+
+func TestParseSpan_CalendarUnits(t *testing.T) {
+	calendar := NewParser(WithCalendarUnits(true))
+
+	tests := []struct {
+		name     string
+		input    string
+		expected span
+		wantErr  error
+	}{
+		{name: "days", input: "2 days", expected: span{days: 2}},
+		{name: "fractional days", input: "1.5 days", expected: span{days: 1, exact: 12 * time.Hour}},
+		{name: "months", input: "3 months", expected: span{months: 3}},
+		{name: "years", input: "2y", expected: span{months: 24}},
+		{name: "fractional years", input: "1.5 years", expected: span{months: 18}},
+		{name: "quarter of a year", input: "0.25y", expected: span{months: 3}},
+		{
+			name:     "mixed",
+			input:    "1 year 2 days 3.5 hours",
+			expected: span{months: 12, days: 2, exact: 3*time.Hour + 30*time.Minute},
+		},
+		{name: "whole fractional month", input: "2.0 months", expected: span{months: 2}},
+		{name: "fractional months", input: "1.5 months", wantErr: ErrFractionalCalendarUnit},
+		{name: "fractional years not whole months", input: "0.1 years", wantErr: ErrFractionalCalendarUnit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := calendar.parseSpan(tt.input)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+				assert.True(t, errors.Is(err, ErrInvalidDuration))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParser_CalendarUnits(t *testing.T) {
+	parser := NewParser(WithCalendarUnits(true))
+	now := time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Time
+	}{
+		{
+			name:     "month ago overflows like AddDate",
+			input:    "1 month ago",
+			expected: time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "year and a half ago",
+			input:    "1.5 years ago",
+			expected: time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "fractional days",
+			input:    "2.25 days ago",
+			expected: time.Date(2025, 3, 29, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "future",
+			input:    "in 1 month",
+			expected: time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parser.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("day across DST change keeps wall clock", func(t *testing.T) {
+		loc, err := time.LoadLocation("Europe/Berlin")
+		if err != nil {
+			t.Skip("time zone data unavailable")
+		}
+
+		// Clocks moved forward in the early hours of March 30, 2025.
+		dstNow := time.Date(2025, 3, 30, 12, 0, 0, 0, loc)
+
+		result, err := parser.ParseTime("1 day ago", dstNow, time.Time{})
+		require.NoError(t, err)
+		assert.True(t, time.Date(2025, 3, 29, 12, 0, 0, 0, loc).Equal(result))
+
+		fixed, err := ParseTime("1 day ago", dstNow, time.Time{})
+		require.NoError(t, err)
+		assert.True(t, time.Date(2025, 3, 29, 11, 0, 0, 0, loc).Equal(fixed))
+	})
+
+	t.Run("fractional months rejected", func(t *testing.T) {
+		_, err := parser.ParseTime("1.5 months ago", now, time.Time{})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrFractionalCalendarUnit))
+		assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+	})
+}
+
+func TestParseTime_FractionalAmounts(t *testing.T) {
+	now := fixedTime()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Time
+	}{
+		{name: "days ago", input: "1.5 days ago", expected: now.Add(-36 * time.Hour)},
+		{name: "short years", input: "0.5y", expected: now.Add(-4380 * time.Hour)},
+		{name: "hours ago", input: "2.25 hours ago", expected: now.Add(-135 * time.Minute)},
+		{name: "months", input: "1.5 months", expected: now.Add(-1080 * time.Hour)},
+		{name: "prefixed", input: "-0.5 days", expected: now.Add(-12 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestParseSpan_Units(t *testing.T) {
+	const day = 24 * time.Hour
+
+	tests := []struct {
+		input    string
+		expected time.Duration
+	}{
+		{input: "2w", expected: 14 * day},
+		{input: "3 weeks", expected: 21 * day},
+		{input: "1 wk", expected: 7 * day},
+		{input: "2 wks", expected: 14 * day},
+		{input: "1 fortnight", expected: 14 * day},
+		{input: "2 fortnights", expected: 28 * day},
+		{input: "1 quarter", expected: 90 * day},
+		{input: "2 qtr", expected: 180 * day},
+		{input: "1 decade", expected: 3650 * day},
+		{input: "1mo", expected: 30 * day},
+		{input: "3 mos", expected: 90 * day},
+		{input: "2 yrs", expected: 730 * day},
+		{input: "3 hrs", expected: 3 * time.Hour},
+		{input: "1 hr", expected: time.Hour},
+		{input: "5 mins", expected: 5 * time.Minute},
+		{input: "10 secs", expected: 10 * time.Second},
+		{input: "250 milliseconds", expected: 250 * time.Millisecond},
+		{input: "250ms", expected: 250 * time.Millisecond},
+		{input: "3 microseconds", expected: 3 * time.Microsecond},
+		{input: "3us", expected: 3 * time.Microsecond},
+		{input: "3µs", expected: 3 * time.Microsecond},
+		{input: "7 nanoseconds", expected: 7 * time.Nanosecond},
+		{input: "7ns", expected: 7 * time.Nanosecond},
+		{input: "5m", expected: 5 * time.Minute},
+		{input: "5M", expected: 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := defaultParser.parseSpan(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, span{exact: tt.expected}, result)
+		})
+	}
+
+	t.Run("calendar units", func(t *testing.T) {
+		calendar := NewParser(WithCalendarUnits(true))
+
+		result, err := calendar.parseSpan("1 decade 1 quarter 1 fortnight 1 week")
+		require.NoError(t, err)
+		assert.Equal(t, span{months: 123, days: 21}, result)
+	})
+}
+
+func TestParseTime_NewUnits(t *testing.T) {
+	now := fixedTime()
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "2w", expected: now.Add(-14 * 24 * time.Hour)},
+		{input: "3 weeks ago", expected: now.Add(-21 * 24 * time.Hour)},
+		{input: "250 milliseconds ago", expected: now.Add(-250 * time.Millisecond)},
+		{input: "2 weeks and 3 days", expected: now.Add(-17 * 24 * time.Hour)},
+		{input: "in 1 qtr", expected: now.Add(90 * 24 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package friendlytime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// esAnchorSeparator separates an absolute date from the date math applied to it.
+const esAnchorSeparator = "||"
+
+// esDateFormat is a layout of Elasticsearch's strict_date_optional_time
+// format, with the unit its least significant field stands for.
+type esDateFormat struct {
+	layout string
+	unit   byte
+}
+
+// getElasticsearchDateFormats returns the layouts of strict_date_optional_time,
+// most specific first.
+func getElasticsearchDateFormats() []esDateFormat {
+	return []esDateFormat{
+		{layout: "2006-01-02T15:04:05Z07:00", unit: unitSecond},
+		{layout: "2006-01-02T15:04:05", unit: unitSecond},
+		{layout: "2006-01-02T15:04Z07:00", unit: unitMinute},
+		{layout: "2006-01-02T15:04", unit: unitMinute},
+		{layout: "2006-01-02T15Z07:00", unit: unitHour},
+		{layout: "2006-01-02T15", unit: unitHour},
+		{layout: "2006-01-02", unit: unitDay},
+		{layout: "2006-01", unit: unitMonth},
+		{layout: "2006", unit: unitYear},
+	}
+}
+
+// parseElasticsearch parses Elasticsearch date math the way Elasticsearch
+// evaluates it in range queries: "now" or a date followed by "||", then a
+// chain of operations such as "+1M/d". Units are y, M, w, d, h (or H), m
+// and s; weeks always start on Monday.
+//
+// Dates use the default strict_date_optional_time||epoch_millis format and
+// are read in the parser's location unless they carry a zone. With roundUp
+// set, as Elasticsearch does for lte and gt bounds, rounding goes to the last
+// millisecond of the unit and plain dates with missing fields are filled in
+// to their latest value: "2025-12" is the last millisecond of December, while
+// the anchor in "2025-12||+1d" stays at the start of December.
+func (p *Parser) parseElasticsearch(timeStr string, now time.Time, roundUp bool) (time.Time, error) {
+	timeStr = strings.TrimSpace(timeStr)
+
+	var anchor time.Time
+
+	var mathStr string
+
+	if rest, ok := strings.CutPrefix(timeStr, "now"); ok {
+		anchor, mathStr = now, rest
+	} else {
+		anchorStr, rest, hasMath := strings.Cut(timeStr, esAnchorSeparator)
+
+		// Only a plain date has its missing fields filled in; an anchor
+		// before "||" is read as-is.
+		t, ok := p.parseElasticsearchDate(anchorStr, roundUp && !hasMath)
+		if !ok {
+			return time.Time{}, fmt.Errorf("%w: %q is not an Elasticsearch date", ErrInvalidTimeFormat, timeStr)
+		}
+
+		anchor, mathStr = t, rest
+	}
+
+	t, ok := p.applyDateMath(mathStr, anchor, roundUp, dateMathSyntax{units: "yMwdhHms", weekStart: time.Monday})
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: invalid Elasticsearch date math %q", ErrInvalidTimeFormat, timeStr)
+	}
+
+	return t, nil
+}
+
+// parseElasticsearchDate parses a date in strict_date_optional_time or
+// epoch_millis format.
+func (p *Parser) parseElasticsearchDate(s string, roundUp bool) (time.Time, bool) {
+	for _, format := range getElasticsearchDateFormats() {
+		t, err := time.ParseInLocation(format.layout, s, p.dateLocation())
+		if err != nil {
+			continue
+		}
+
+		t = p.localize(t)
+
+		// Fractional seconds leave nothing to fill in.
+		if roundUp && t.Nanosecond() == 0 {
+			t = endOfUnit(t, format.unit, time.Monday)
+		}
+
+		return t, true
+	}
+
+	millis, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return p.localize(time.UnixMilli(millis)), true
+}
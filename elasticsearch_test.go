@@ -0,0 +1,116 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBounds_Elasticsearch(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	parser := NewParser(WithDialect(ElasticsearchDialect), WithClock(fixedTime))
+
+	tests := []struct {
+		from  string
+		to    string
+		start time.Time
+		end   time.Time
+	}{
+		{from: "now-1d/d", to: "now/d", start: midnight(2025, 12, 9), end: endOfDay(2025, 12, 10)},
+		{from: "now-1h/H", to: "now-1h/h", start: time.Date(2025, 12, 10, 14, 0, 0, 0, time.UTC), end: time.Date(2025, 12, 10, 14, 59, 59, 999000000, time.UTC)},
+		{from: "2025-12-10||+1M/d", to: "2025-12-10||+1M/d", start: midnight(2026, 1, 10), end: endOfDay(2026, 1, 10)},
+		{from: "2025-01-31||+1M", to: "2025-01-31||+1M+1d", start: midnight(2025, 2, 28), end: midnight(2025, 3, 1)},
+		{from: "now/w", to: "now/w", start: midnight(2025, 12, 8), end: endOfDay(2025, 12, 14)},
+		{from: "now/M", to: "now/y", start: midnight(2025, 12, 1), end: endOfDay(2025, 12, 31)},
+		{from: "2025-12", to: "2025-12", start: midnight(2025, 12, 1), end: endOfDay(2025, 12, 31)},
+		{from: "2025", to: "2025", start: midnight(2025, 1, 1), end: endOfDay(2025, 12, 31)},
+		{
+			from:  "2025-12-10T15:04",
+			to:    "2025-12-10T15:04",
+			start: time.Date(2025, 12, 10, 15, 4, 0, 0, time.UTC),
+			end:   time.Date(2025, 12, 10, 15, 4, 59, 999000000, time.UTC),
+		},
+		{
+			from:  "2025-12-10T15:04:05.5Z",
+			to:    "2025-12-10T17:04:05.5+02:00",
+			start: time.Date(2025, 12, 10, 15, 4, 5, 500000000, time.UTC),
+			end:   time.Date(2025, 12, 10, 15, 4, 5, 500000000, time.UTC),
+		},
+		{from: "1416434697000", to: "1416434697000||+1s", start: time.UnixMilli(1416434697000), end: time.UnixMilli(1416434698000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+" to "+tt.to, func(t *testing.T) {
+			r, err := parser.ParseBounds(tt.from, tt.to)
+			require.NoError(t, err)
+			assert.True(t, tt.start.Equal(r.Start), "expected start %v, got %v", tt.start, r.Start)
+			assert.True(t, tt.end.Equal(r.End), "expected end %v, got %v", tt.end, r.End)
+		})
+	}
+
+	for _, input := range []string{"now/2d", "now-1Q", "now-1y/", "now-", "now-1", "yesterday", "2025-13-01", "2025-12-10||+1x"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parser.ParseBounds(input, "now")
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+}
+
+func TestParser_ElasticsearchOptions(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+
+	t.Run("round up", func(t *testing.T) {
+		parser := NewParser(WithDialect(ElasticsearchDialect), WithRoundUp(true))
+
+		result, err := parser.ParseTime("now/d", now, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, endOfDay(2025, 12, 10), result)
+
+		result, err = parser.ParseTime("2025-12-10", now, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, endOfDay(2025, 12, 10), result)
+
+		// An anchor before "||" is not filled in; only the math rounds up.
+		result, err = parser.ParseTime("2025-12-10||+1h", now, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 12, 10, 1, 0, 0, 0, time.UTC), result)
+
+		result, err = parser.ParseTime("2025-12-10||+1h/d", now, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, endOfDay(2025, 12, 10), result)
+
+		result, err = NewParser(WithDialect(ElasticsearchDialect)).ParseTime("now/d", now, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, midnight(2025, 12, 10), result)
+	})
+
+	t.Run("weeks start on monday", func(t *testing.T) {
+		parser := NewParser(WithDialect(ElasticsearchDialect), WithWeekStart(time.Sunday))
+
+		result, err := parser.ParseTime("now/w", now, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, midnight(2025, 12, 8), result)
+	})
+
+	t.Run("time zone", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Skip("time zone data unavailable")
+		}
+
+		parser := NewParser(WithDialect(ElasticsearchDialect), WithLocation(loc))
+
+		result, err := parser.ParseTime("2025-12-10||/d", now, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 12, 10, 0, 0, 0, 0, loc), result)
+
+		result, err = parser.ParseTime("2025-12-10T03:00:00Z||/d", now, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 12, 9, 0, 0, 0, 0, loc), result)
+	})
+}
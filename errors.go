@@ -19,6 +19,31 @@ var (
 	// ErrInvalidWeekday indicates an unrecognized weekday name was provided.
 	ErrInvalidWeekday = errors.New("invalid weekday")
 
+	// ErrInvalidDuration indicates a duration expression could not be parsed.
+	ErrInvalidDuration = errors.New("invalid duration")
+
+	// ErrFractionalCalendarUnit indicates a fractional amount of a calendar unit that cannot be applied exactly.
+	ErrFractionalCalendarUnit = errors.New("fractional calendar unit")
+
+	// ErrNonexistentDate indicates a calendar expression refers to a date that does not exist (e.g., a fifth Monday).
+	ErrNonexistentDate = errors.New("date does not exist")
+
+	// ErrInvalidRecurrence indicates a repeating interval such as "R5/2025-12-10T09:00Z/PT1H" could not be parsed.
+	ErrInvalidRecurrence = errors.New("invalid recurrence")
+
+	// ErrInvalidStep indicates the step of a range query could not be parsed or is not positive.
+	ErrInvalidStep = errors.New("invalid step")
+
+	// ErrMaxResolution indicates a range query would have more points than the backend allows.
+	ErrMaxResolution = errors.New("exceeded maximum resolution")
+
+	// ErrInfinity indicates PostgreSQL's "infinity", a time later than any other, which has no time.Time value.
+	ErrInfinity = errors.New("infinity")
+
+	// ErrNegativeInfinity indicates PostgreSQL's "-infinity", a time earlier than any other, which has no time.Time
+	// value.
+	ErrNegativeInfinity = errors.New("-infinity")
+
 	// ErrEndBeforeStart indicates the end time is chronologically before the start time.
 	ErrEndBeforeStart = errors.New("end time is before start time")
 )
@@ -123,3 +123,52 @@ func ExampleParseTimeRange_errorHandling() {
 		fmt.Printf("Error: %v\n", err)
 	}
 }
+
+// ExampleNewParser shows calendar-aware month and year arithmetic.
+func ExampleNewParser() {
+	parser := friendlytime.NewParser(friendlytime.WithCalendarUnits(true))
+	now := time.Date(2025, 12, 10, 15, 30, 0, 0, time.UTC)
+
+	t, _ := parser.ParseTime("1.5 years ago", now, time.Time{})
+	fmt.Printf("1.5 years ago: %v\n", t.Format("2006-01-02"))
+
+	_, err := parser.ParseTime("1.5 months ago", now, time.Time{})
+	fmt.Printf("Error: %v\n", err)
+}
+
+// ExampleParseRange shows a quarter expanded to its full range.
+func ExampleParseRange() {
+	r, err := friendlytime.ParseRange("Q3 2025")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+
+		return
+	}
+
+	fmt.Printf("Start: %v\n", r.Start.Format("2006-01-02"))
+	fmt.Printf("End: %v\n", r.End.Format("2006-01-02"))
+	// Output:
+	// Start: 2025-07-01
+	// End: 2025-10-01
+}
+
+// ExampleParseRecurrence shows hourly maintenance windows from a repeating interval.
+func ExampleParseRecurrence() {
+	r, err := friendlytime.ParseRecurrence("R3/2025-12-10T09:00Z/PT1H")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+
+		return
+	}
+
+	from := time.Date(2025, 12, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 12, 11, 0, 0, 0, 0, time.UTC)
+
+	for _, occurrence := range r.Between(from, to) {
+		fmt.Printf("%v-%v\n", occurrence.Start.Format("15:04"), occurrence.End.Format("15:04"))
+	}
+	// Output:
+	// 09:00-10:00
+	// 10:00-11:00
+	// 11:00-12:00
+}
@@ -109,8 +109,8 @@ func isExpectedTimeRangeError(err error) bool {
 		errors.Is(err, ErrEndBeforeStart)
 }
 
-// FuzzConvertCustomUnits tests the unit conversion function.
-func FuzzConvertCustomUnits(f *testing.F) {
+// FuzzParseDuration tests the duration parser with random inputs.
+func FuzzParseDuration(f *testing.F) {
 	seeds := []string{
 		"30 seconds",
 		"15 minutes",
@@ -120,6 +120,8 @@ func FuzzConvertCustomUnits(f *testing.F) {
 		"2 years",
 		"5d",
 		"1y",
+		"1 day 2 hours",
+		"1 year, 6 months and 3 days",
 		"last monday",
 		"yesterday",
 		"",
@@ -130,10 +132,18 @@ func FuzzConvertCustomUnits(f *testing.F) {
 	}
 
 	f.Fuzz(func(t *testing.T, input string) {
-		result := convertCustomUnits(input)
+		duration, err := defaultParser.parseSpan(input)
+		if err != nil {
+			if !errors.Is(err, ErrInvalidDuration) {
+				t.Errorf("Expected ErrInvalidDuration for input %q, got %v", input, err)
+			}
 
-		if input != "" && result == "" {
-			t.Errorf("Result should not be empty for non-empty input: %q", input)
+			return
+		}
+
+		// Durations have no sign; "ago" and "in" are applied by the caller.
+		if duration.months < 0 || duration.days < 0 || duration.exact < 0 {
+			t.Errorf("Expected a non-negative duration for input %q, got %+v", input, duration)
 		}
 	})
 }
@@ -0,0 +1,558 @@
+package friendlytime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// gnuTeatime is the hour git's approxidate means by "tea".
+	gnuTeatime = 17
+	// gnuUSDateParts is the most fields of a "MM/DD/YY" date.
+	gnuUSDateParts = 3
+)
+
+// gnuDate collects the items of a GNU date string before they are resolved
+// against now, the way GNU date collects them before calling mktime.
+type gnuDate struct {
+	hasDate bool
+	yearSet bool
+	year    int
+	month   time.Month
+	day     int
+
+	hasTime                 bool
+	hour, minute, sec, nsec int
+	zone                    *time.Location
+
+	hasWeekday bool
+	weekday    time.Weekday
+	ordinal    int
+
+	rel     span // relative items already signed by "ago" or "hence"
+	pending span // relative items since the last "ago" or "hence"
+}
+
+// getGNUWeekdayNames returns the weekday names of GNU date, indexed by time.Weekday.
+func getGNUWeekdayNames() [][]string {
+	return [][]string{
+		{"sunday", "sun"},
+		{"monday", "mon"},
+		{"tuesday", "tues", "tue"},
+		{"wednesday", "wednes", "wed"},
+		{"thursday", "thurs", "thur", "thu"},
+		{"friday", "fri"},
+		{"saturday", "sat"},
+	}
+}
+
+// getGNUOrdinals returns GNU date's ordinal words and their values. "second"
+// is missing on purpose: GNU date reads it as the unit.
+func getGNUOrdinals() map[string]int {
+	return map[string]int{
+		"last": -1, "this": 0, "next": 1, "first": 1, "third": 3, "fourth": 4,
+		"fifth": 5, "sixth": 6, "seventh": 7, "eighth": 8, "ninth": 9, "tenth": 10,
+		"eleventh": 11, "twelfth": 12,
+	}
+}
+
+// parseGNUWeekday parses a weekday name as GNU date spells it.
+func parseGNUWeekday(word string) (time.Weekday, bool) {
+	for day, names := range getGNUWeekdayNames() {
+		for _, name := range names {
+			if name == word {
+				return time.Weekday(day), true
+			}
+		}
+	}
+
+	return time.Sunday, false
+}
+
+// parseGNU parses a date string the way GNU date -d and git's approxidate
+// read relative items:
+//
+//   - relative items: "2 days", "+1 week", "3 hours ago", "a week ago",
+//     "next month", "yesterday", "tomorrow", with "ago" negating the items
+//     before it
+//   - day items: "thursday", "next thursday", "last friday", "third monday"
+//   - times: "17:30", "5pm", "8:02pm", "20:02:00.5", "noon", "midnight"
+//   - dates: "2025-12-10", "12/10/2025", "10 dec 2025", "december 10, 2025"
+//   - "@" followed by Unix seconds
+//
+// Items may be separated by spaces, commas or, as in git, dots: "2.weeks.ago"
+// is "2 weeks ago". Items combine as in "last friday 5pm" and "1 month ago
+// noon". As in GNU date, a date or day item without a time means midnight,
+// an empty string is the start of today, and a day item moves to the given
+// weekday: "thursday" and "this thursday" are today or later, "next
+// thursday" is the first one after today, "last thursday" the one a week
+// before "thursday", and "third thursday" two weeks after "next thursday".
+func (p *Parser) parseGNU(timeStr string, now time.Time) (time.Time, error) {
+	timeStr = strings.ToLower(strings.TrimSpace(timeStr))
+	if timeStr == "" {
+		return getMidnight(now), nil
+	}
+
+	if rest, ok := strings.CutPrefix(timeStr, "@"); ok {
+		t, ok := parseDecimalTimestamp(strings.TrimSpace(rest), time.Second)
+		if !ok {
+			return time.Time{}, fmt.Errorf("%w: invalid timestamp %q", ErrInvalidTimeFormat, timeStr)
+		}
+
+		return p.localize(t), nil
+	}
+
+	tokens, ok := lexGNU(timeStr)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: unexpected character in %q", ErrInvalidTimeFormat, timeStr)
+	}
+
+	var date gnuDate
+
+	for len(tokens) > 0 {
+		var err error
+
+		tokens, err = date.parseItem(tokens)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w in %q", err, timeStr)
+		}
+	}
+
+	return date.resolve(now, timeStr)
+}
+
+// lexGNU splits a GNU date string into words and numbers. Numbers keep the
+// separators of dates and times ("2025-12-10", "17:30", "12/10"), and a
+// leading sign when they start an item.
+func lexGNU(s string) ([]string, bool) {
+	var tokens []string
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		switch {
+		case c == ' ' || c == ',' || c == '\t' || c == '.':
+			i++
+		case isDigit(c) || ((c == '+' || c == '-') && i+1 < len(s) && isDigit(s[i+1])):
+			start := i
+			i++
+
+			for i < len(s) && (isDigit(s[i]) || (strings.IndexByte(":/-.+", s[i]) >= 0 && i+1 < len(s) && isDigit(s[i+1]))) {
+				i++
+			}
+
+			tokens = append(tokens, s[start:i])
+		default:
+			word, _ := cutWord(s[i:])
+			if word == "" {
+				return nil, false
+			}
+
+			tokens = append(tokens, word)
+			i += len(word)
+		}
+	}
+
+	return tokens, true
+}
+
+// parseItem parses the item at the start of tokens and returns the rest.
+func (d *gnuDate) parseItem(tokens []string) ([]string, error) {
+	token, rest := tokens[0], tokens[1:]
+
+	if isDigit(token[0]) || token[0] == '+' || token[0] == '-' {
+		return d.parseNumberItem(token, rest)
+	}
+
+	// git's approxidate reads "a week ago" as "1 week ago".
+	if (token == "a" || token == "an") && len(rest) > 0 {
+		if unit, ok := lookupDurationUnit(rest[0]); ok {
+			d.addRelative(1, unit)
+
+			return rest[1:], nil
+		}
+	}
+
+	if ordinal, ok := getGNUOrdinals()[token]; ok && len(rest) > 0 {
+		if weekday, ok := parseGNUWeekday(rest[0]); ok {
+			return rest[1:], d.setWeekday(weekday, ordinal)
+		}
+
+		if unit, ok := lookupDurationUnit(rest[0]); ok {
+			d.addRelative(ordinal, unit)
+
+			return rest[1:], nil
+		}
+	}
+
+	if weekday, ok := parseGNUWeekday(token); ok {
+		return rest, d.setWeekday(weekday, 0)
+	}
+
+	if month, ok := parseMonth(token); ok {
+		if len(rest) == 0 || !isAllDigits(rest[0]) {
+			return nil, fmt.Errorf("%w: missing day after %q", ErrInvalidTimeFormat, token)
+		}
+
+		day, _ := parseDigits(rest[0], 1, fieldDigits)
+
+		return d.setNamedDate(month, day, skipDaySuffix(rest[1:]))
+	}
+
+	if unit, ok := lookupDurationUnit(token); ok {
+		d.addRelative(1, unit)
+
+		return rest, nil
+	}
+
+	return rest, d.parseWord(token)
+}
+
+// parseWord applies a keyword item such as "yesterday", "noon" or "ago".
+func (d *gnuDate) parseWord(word string) error {
+	switch word {
+	case "now", "today":
+	case "yesterday":
+		d.pending.days--
+	case "tomorrow":
+		d.pending.days++
+	case "noon":
+		return d.setTime(hoursPerHalfDay, 0, 0, 0)
+	case "midnight":
+		return d.setTime(0, 0, 0, 0)
+	case "tea":
+		return d.setTime(gnuTeatime, 0, 0, 0)
+	case "ago":
+		d.commitRelative(-1)
+	case "hence":
+		d.commitRelative(1)
+	case "utc", "gmt":
+		d.zone = time.UTC
+	case "t":
+		// The separator of ISO 8601 date and time, as in "2025-12-10t15:04".
+		if !d.hasDate {
+			return fmt.Errorf("%w: unexpected %q", ErrInvalidTimeFormat, word)
+		}
+	default:
+		return fmt.Errorf("%w: unknown word %q", ErrInvalidTimeFormat, word)
+	}
+
+	return nil
+}
+
+// parseNumberItem parses an item starting with a number: a time, a date, or
+// an amount of a relative item, day item or day of month.
+func (d *gnuDate) parseNumberItem(token string, rest []string) ([]string, error) {
+	signed := token[0] == '+' || token[0] == '-'
+
+	switch {
+	case strings.Contains(token, ":"):
+		return d.parseTimeItem(token, rest)
+	case !signed && strings.ContainsAny(token, "-/."):
+		return rest, d.parseDateItem(token)
+	}
+
+	n, ok := parseSignedInt(token)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid number %q", ErrInvalidTimeFormat, token)
+	}
+
+	if len(rest) > 0 {
+		if rest, ok, err := d.parseAmountItem(n, rest); ok {
+			return rest, err
+		}
+	}
+
+	if signed {
+		return nil, fmt.Errorf("%w: unexpected number %q", ErrInvalidTimeFormat, token)
+	}
+
+	return d.parseDayOrYearItem(n, token, rest)
+}
+
+// parseTimeItem parses a time of day such as "17:30", "8:02pm" or
+// "20:02-0500", with an optional zone attached.
+func (d *gnuDate) parseTimeItem(token string, rest []string) ([]string, error) {
+	hour, minute, sec, nsec, zone, ok := parseClock(token)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid time %q", ErrInvalidTimeFormat, token)
+	}
+
+	if zone != nil {
+		d.zone = zone
+	}
+
+	if len(rest) > 0 && (rest[0] == "am" || rest[0] == "pm") {
+		var err error
+
+		hour, err = meridiemHour(hour, rest[0])
+		if err != nil {
+			return nil, err
+		}
+
+		rest = rest[1:]
+	}
+
+	return rest, d.setTime(hour, minute, sec, nsec)
+}
+
+// parseDateItem parses a numeric date: "YYYY-MM-DD", "MM/DD/YYYY", or git's
+// "DD.MM.YYYY".
+func (d *gnuDate) parseDateItem(token string) error {
+	if strings.Contains(token, "/") {
+		return d.parseUSDate(token)
+	}
+
+	if parts := strings.Split(token, "."); len(parts) > 1 {
+		if len(parts) != numericDateParts {
+			return fmt.Errorf("%w: invalid date %q", ErrInvalidTimeFormat, token)
+		}
+
+		token = parts[2] + "-" + parts[1] + "-" + parts[0]
+	}
+
+	year, month, day, ok := parseNumericDate(token)
+	if !ok {
+		return fmt.Errorf("%w: invalid date %q", ErrInvalidTimeFormat, token)
+	}
+
+	return d.setDate(year, month, day, true)
+}
+
+// parseAmountItem parses the word after an amount n: an hour followed by
+// "am" or "pm", a relative item such as "2 days", or a day item such as
+// "2 thursday". It reports false if the word is none of these.
+func (d *gnuDate) parseAmountItem(n int, rest []string) ([]string, bool, error) {
+	next := rest[0]
+
+	if next == "am" || next == "pm" {
+		hour, err := meridiemHour(n, next)
+		if err != nil {
+			return nil, true, err
+		}
+
+		return rest[1:], true, d.setTime(hour, 0, 0, 0)
+	}
+
+	if unit, ok := lookupDurationUnit(next); ok {
+		d.addRelative(n, unit)
+
+		return rest[1:], true, nil
+	}
+
+	if weekday, ok := parseGNUWeekday(next); ok {
+		return rest[1:], true, d.setWeekday(weekday, n)
+	}
+
+	return rest, false, nil
+}
+
+// parseDayOrYearItem parses a number that is the day of a following month
+// name, as in "10 dec" or "5th july", or the year of a date given earlier.
+func (d *gnuDate) parseDayOrYearItem(n int, token string, rest []string) ([]string, error) {
+	rest = skipDaySuffix(rest)
+	if len(rest) > 0 {
+		if month, ok := parseMonth(rest[0]); ok {
+			return d.setNamedDate(month, n, rest[1:])
+		}
+	}
+
+	if d.hasDate && !d.yearSet && len(token) == yearDigits {
+		d.year, d.yearSet = n, true
+
+		return rest, nil
+	}
+
+	return nil, fmt.Errorf("%w: unexpected number %q", ErrInvalidTimeFormat, token)
+}
+
+// parseUSDate parses a "MM/DD" or "MM/DD/YY[YY]" date.
+func (d *gnuDate) parseUSDate(token string) error {
+	parts := strings.Split(token, "/")
+	if len(parts) < gnuUSDateParts-1 || len(parts) > gnuUSDateParts {
+		return fmt.Errorf("%w: invalid date %q", ErrInvalidTimeFormat, token)
+	}
+
+	month, okMonth := parseDigits(parts[0], 1, fieldDigits)
+	day, okDay := parseDigits(parts[1], 1, fieldDigits)
+
+	if !okMonth || !okDay || month < 1 || month > monthsPerYear || day < 1 {
+		return fmt.Errorf("%w: invalid date %q", ErrInvalidTimeFormat, token)
+	}
+
+	if len(parts) == gnuUSDateParts-1 {
+		return d.setDate(0, time.Month(month), day, false)
+	}
+
+	year, _, _, ok := parseNumericDate(parts[2] + "-01-01")
+	if !ok {
+		return fmt.Errorf("%w: invalid date %q", ErrInvalidTimeFormat, token)
+	}
+
+	return d.setDate(year, time.Month(month), day, true)
+}
+
+// setNamedDate sets a date written with a month name, such as "dec 10" or
+// "10 dec", taking a following four-digit number as its year.
+func (d *gnuDate) setNamedDate(month time.Month, day int, rest []string) ([]string, error) {
+	if len(rest) > 0 && len(rest[0]) == yearDigits && isAllDigits(rest[0]) &&
+		(len(rest) == 1 || (rest[1] != "am" && rest[1] != "pm")) {
+		year, _ := parseDigits(rest[0], yearDigits, yearDigits)
+
+		return rest[1:], d.setDate(year, month, day, true)
+	}
+
+	return rest, d.setDate(0, month, day, false)
+}
+
+// setDate records a calendar date item.
+func (d *gnuDate) setDate(year int, month time.Month, day int, yearSet bool) error {
+	if d.hasDate {
+		return fmt.Errorf("%w: more than one date", ErrInvalidTimeFormat)
+	}
+
+	if month < time.January || month > time.December || day < 1 || day > maxDayOfMonth {
+		return fmt.Errorf("%w: invalid date", ErrInvalidTimeFormat)
+	}
+
+	d.hasDate, d.year, d.month, d.day, d.yearSet = true, year, month, day, yearSet
+
+	return nil
+}
+
+// setTime records a time of day item.
+func (d *gnuDate) setTime(hour, minute, sec, nsec int) error {
+	if d.hasTime {
+		return fmt.Errorf("%w: more than one time of day", ErrInvalidTimeFormat)
+	}
+
+	d.hasTime, d.hour, d.minute, d.sec, d.nsec = true, hour, minute, sec, nsec
+
+	return nil
+}
+
+// setWeekday records a day item.
+func (d *gnuDate) setWeekday(weekday time.Weekday, ordinal int) error {
+	if d.hasWeekday {
+		return fmt.Errorf("%w: more than one day of the week", ErrInvalidTimeFormat)
+	}
+
+	d.hasWeekday, d.weekday, d.ordinal = true, weekday, ordinal
+
+	return nil
+}
+
+// addRelative records n units as a relative item.
+func (d *gnuDate) addRelative(n int, unit durationUnit) {
+	switch {
+	case unit.months > 0:
+		d.pending.months += n * unit.months
+	case unit.days > 0:
+		d.pending.days += n * unit.days
+	default:
+		d.pending.exact += time.Duration(n) * unit.length
+	}
+}
+
+// commitRelative moves the pending relative items into the result, negated
+// for "ago".
+func (d *gnuDate) commitRelative(sign int) {
+	d.rel.months += sign * d.pending.months
+	d.rel.days += sign * d.pending.days
+	d.rel.exact += time.Duration(sign) * d.pending.exact
+	d.pending = span{}
+}
+
+// resolve turns the collected items into a time relative to now.
+func (d *gnuDate) resolve(now time.Time, timeStr string) (time.Time, error) {
+	d.commitRelative(1)
+
+	loc := now.Location()
+	if d.zone != nil {
+		loc = d.zone
+	}
+
+	base := now.In(loc)
+	year, month, day := base.Date()
+	hour, minute, sec, nsec := base.Hour(), base.Minute(), base.Second(), base.Nanosecond()
+
+	if d.hasDate {
+		month, day = d.month, d.day
+		if d.yearSet {
+			year = d.year
+		}
+
+		if day > daysIn(year, month) {
+			return time.Time{}, fmt.Errorf("%w: %w: %q", ErrInvalidTimeFormat, ErrNonexistentDate, timeStr)
+		}
+	}
+
+	switch {
+	case d.hasTime:
+		hour, minute, sec, nsec = d.hour, d.minute, d.sec, d.nsec
+	case d.hasDate || d.hasWeekday:
+		hour, minute, sec, nsec = 0, 0, 0, 0
+	}
+
+	t := time.Date(year, month, day, hour, minute, sec, nsec, loc)
+
+	if d.hasWeekday && !d.hasDate {
+		ordinal := d.ordinal
+		if ordinal > 0 && t.Weekday() != d.weekday {
+			ordinal--
+		}
+
+		daysAhead := (int(d.weekday) - int(t.Weekday()) + daysPerWeek) % daysPerWeek
+		t = t.AddDate(0, 0, daysAhead+daysPerWeek*ordinal)
+	}
+
+	return d.rel.addTo(t, 1), nil
+}
+
+// meridiemHour converts a 12-hour clock hour followed by "am" or "pm".
+func meridiemHour(hour int, meridiem string) (int, error) {
+	if hour < 1 || hour > hoursPerHalfDay {
+		return 0, fmt.Errorf("%w: invalid hour %d%s", ErrInvalidTimeFormat, hour, meridiem)
+	}
+
+	hour %= hoursPerHalfDay
+	if meridiem == "pm" {
+		hour += hoursPerHalfDay
+	}
+
+	return hour, nil
+}
+
+// skipDaySuffix drops the ordinal suffix of a day of month, as in "5th".
+func skipDaySuffix(tokens []string) []string {
+	if len(tokens) > 0 {
+		switch tokens[0] {
+		case "st", "nd", "rd", "th":
+			return tokens[1:]
+		}
+	}
+
+	return tokens
+}
+
+// parseSignedInt parses an integer with an optional sign.
+func parseSignedInt(s string) (int, bool) {
+	sign := 1
+
+	switch s[0] {
+	case '+':
+		s = s[1:]
+	case '-':
+		sign, s = -1, s[1:]
+	}
+
+	if !isAllDigits(s) {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(s)
+
+	return sign * n, err == nil
+}
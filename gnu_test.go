@@ -0,0 +1,139 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseTime_GNUConformance checks the examples of the "Date input
+// formats" chapter of the GNU coreutils manual.
+func TestParseTime_GNUConformance(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	parser := NewParser(WithDialect(GNUDialect))
+	nov14 := midnight(2022, 11, 14)
+	at2002 := time.Date(2025, 12, 10, 20, 2, 0, 0, time.UTC)
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		// Calendar date items.
+		{input: "2022-11-14", expected: nov14},
+		{input: "22-11-14", expected: nov14},
+		{input: "11/14/2022", expected: nov14},
+		{input: "14 November 2022", expected: nov14},
+		{input: "14 Nov 2022", expected: nov14},
+		{input: "November 14, 2022", expected: nov14},
+		{input: "Nov 14, 2022", expected: nov14},
+		{input: "nov 14", expected: midnight(2025, 11, 14)},
+		// Time of day items.
+		{input: "20:02:00.000000", expected: at2002},
+		{input: "20:02", expected: at2002},
+		{input: "8:02pm", expected: at2002},
+		{input: "20:02-0500", expected: time.Date(2025, 12, 11, 1, 2, 0, 0, time.UTC)},
+		// Day of week items.
+		{input: "Thursday", expected: midnight(2025, 12, 11)},
+		{input: "thur", expected: midnight(2025, 12, 11)},
+		{input: "this thursday", expected: midnight(2025, 12, 11)},
+		{input: "next thursday", expected: midnight(2025, 12, 11)},
+		{input: "wednesday", expected: midnight(2025, 12, 10)},
+		{input: "next wednesday", expected: midnight(2025, 12, 17)},
+		{input: "last wednesday", expected: midnight(2025, 12, 3)},
+		{input: "third monday", expected: midnight(2025, 12, 29)},
+		// Relative items.
+		{input: "1 year", expected: now.AddDate(1, 0, 0)},
+		{input: "1 year ago", expected: now.AddDate(-1, 0, 0)},
+		{input: "3 years", expected: now.AddDate(3, 0, 0)},
+		{input: "2 days", expected: now.AddDate(0, 0, 2)},
+		{input: "-2 days", expected: now.AddDate(0, 0, -2)},
+		{input: "+1 week", expected: now.AddDate(0, 0, 7)},
+		{input: "next week", expected: now.AddDate(0, 0, 7)},
+		{input: "last month", expected: now.AddDate(0, -1, 0)},
+		{input: "2 hours hence", expected: now.Add(2 * time.Hour)},
+		{input: "tomorrow", expected: now.AddDate(0, 0, 1)},
+		{input: "yesterday", expected: now.AddDate(0, 0, -1)},
+		{input: "today", expected: now},
+		{input: "now", expected: now},
+		// Combined items.
+		{input: "last friday 5pm", expected: time.Date(2025, 12, 5, 17, 0, 0, 0, time.UTC)},
+		{input: "1 month ago noon", expected: time.Date(2025, 11, 10, 12, 0, 0, 0, time.UTC)},
+		{input: "tomorrow 9am", expected: time.Date(2025, 12, 11, 9, 0, 0, 0, time.UTC)},
+		{input: "2025-12-01 14:00 utc", expected: time.Date(2025, 12, 1, 14, 0, 0, 0, time.UTC)},
+		{input: "2025-12-01T14:00", expected: time.Date(2025, 12, 1, 14, 0, 0, 0, time.UTC)},
+		// Seconds since the epoch, and the empty string.
+		{input: "@1078100502", expected: time.Unix(1078100502, 0)},
+		{input: "@1416434697", expected: time.Unix(1416434697, 0)},
+		{input: "", expected: midnight(2025, 12, 10)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := parser.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+
+	for _, input := range []string{"blah", "5 parsecs ago", "@x", "13/40/2025", "13pm", "2025-12-01 noon 5pm", "-"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parser.ParseTime(input, now, time.Time{})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+
+	t.Run("nonexistent date", func(t *testing.T) {
+		_, err := parser.ParseTime("feb 30 2025", now, time.Time{})
+		assert.True(t, errors.Is(err, ErrNonexistentDate))
+	})
+}
+
+// TestParseTime_ApproxidateInputs checks inputs taken from git's approxidate
+// tests (t0006-date.sh). It is not a git conformance test: the expectations
+// follow GNU date semantics, so day and date items without a time mean
+// midnight, where git keeps the current time ("last tuesday" is 2009-08-25
+// 19:20:00 in git's tests).
+func TestParseTime_ApproxidateInputs(t *testing.T) {
+	// Sunday, August 30, 2009, 19:20:00, as in git's tests
+	now := time.Date(2009, 8, 30, 19, 20, 0, 0, time.UTC)
+	parser := NewParser(WithDialect(GNUDialect))
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "now", expected: now},
+		{input: "5 seconds ago", expected: time.Date(2009, 8, 30, 19, 19, 55, 0, time.UTC)},
+		{input: "5.seconds.ago", expected: time.Date(2009, 8, 30, 19, 19, 55, 0, time.UTC)},
+		{input: "10.minutes.ago", expected: time.Date(2009, 8, 30, 19, 10, 0, 0, time.UTC)},
+		{input: "yesterday", expected: time.Date(2009, 8, 29, 19, 20, 0, 0, time.UTC)},
+		{input: "3.days.ago", expected: time.Date(2009, 8, 27, 19, 20, 0, 0, time.UTC)},
+		{input: "2.weeks.ago", expected: time.Date(2009, 8, 16, 19, 20, 0, 0, time.UTC)},
+		{input: "3.weeks.ago", expected: time.Date(2009, 8, 9, 19, 20, 0, 0, time.UTC)},
+		{input: "3.months.ago", expected: time.Date(2009, 5, 30, 19, 20, 0, 0, time.UTC)},
+		{input: "2.years.3.months.ago", expected: time.Date(2007, 5, 30, 19, 20, 0, 0, time.UTC)},
+		{input: "a week ago", expected: time.Date(2009, 8, 23, 19, 20, 0, 0, time.UTC)},
+		{input: "an hour ago", expected: time.Date(2009, 8, 30, 18, 20, 0, 0, time.UTC)},
+		{input: "noon today", expected: time.Date(2009, 8, 30, 12, 0, 0, 0, time.UTC)},
+		{input: "noon yesterday", expected: time.Date(2009, 8, 29, 12, 0, 0, 0, time.UTC)},
+		{input: "last tuesday", expected: time.Date(2009, 8, 25, 0, 0, 0, 0, time.UTC)},
+		{input: "last friday", expected: time.Date(2009, 8, 28, 0, 0, 0, 0, time.UTC)},
+		{input: "Jun 6, 5AM", expected: time.Date(2009, 6, 6, 5, 0, 0, 0, time.UTC)},
+		{input: "5AM Jun 6", expected: time.Date(2009, 6, 6, 5, 0, 0, 0, time.UTC)},
+		{input: "6AM, June 7, 2009", expected: time.Date(2009, 6, 7, 6, 0, 0, 0, time.UTC)},
+		{input: "July 5th", expected: time.Date(2009, 7, 5, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := parser.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+}
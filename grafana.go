@@ -0,0 +1,105 @@
+package friendlytime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// grafanaAnchorSeparator separates an absolute time from the date math applied to it.
+	grafanaAnchorSeparator = "||"
+	// grafanaMaxNumberEnd is the index past which Grafana stops reading the amount of an operation.
+	grafanaMaxNumberEnd = 10
+)
+
+// parseGrafana parses a Grafana time expression the way Grafana's date math
+// does: "now" or an absolute time followed by "||", then a chain of
+// operations. "+<n><unit>" and "-<n><unit>" add and subtract, and "/<unit>"
+// rounds to the start of the unit, or to its last millisecond when roundUp
+// is set, as Grafana does for the "to" side of a range. Units are y, Q, M,
+// w, d, h, m and s, and "/fy" and "/fQ" round to fiscal years and quarters.
+//
+// Absolute times are ISO 8601 or Unix milliseconds, as in Grafana URLs.
+// Weeks round to the parser's week start, and rounding happens in the
+// parser's location.
+func (p *Parser) parseGrafana(timeStr string, now time.Time, roundUp bool) (time.Time, error) {
+	timeStr = strings.TrimSpace(timeStr)
+
+	var anchor time.Time
+
+	var mathStr string
+
+	if rest, ok := strings.CutPrefix(timeStr, "now"); ok {
+		anchor, mathStr = now, rest
+	} else {
+		anchorStr, rest, _ := strings.Cut(timeStr, grafanaAnchorSeparator)
+
+		t, ok := p.parseGrafanaAnchor(anchorStr)
+		if !ok {
+			return time.Time{}, fmt.Errorf("%w: %q is not a Grafana time", ErrInvalidTimeFormat, timeStr)
+		}
+
+		anchor, mathStr = t, rest
+	}
+
+	t, ok := p.applyDateMath(mathStr, anchor, roundUp, p.grafanaDateMath())
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: invalid Grafana date math %q", ErrInvalidTimeFormat, timeStr)
+	}
+
+	return t, nil
+}
+
+// parseGrafanaAnchor parses the absolute part of a Grafana time expression.
+func (p *Parser) parseGrafanaAnchor(s string) (time.Time, bool) {
+	if millis, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return p.localize(time.UnixMilli(millis)), true
+	}
+
+	t, ok := parseISOTimestamp(s, p.dateLocation())
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return p.localize(t), true
+}
+
+// roundToFiscal rounds t to the start, or the last millisecond, of its fiscal
+// year or fiscal quarter. Other units round as usual.
+func (p *Parser) roundToFiscal(t time.Time, unit byte, roundUp bool) time.Time {
+	months := 0
+
+	switch unit {
+	case unitYear:
+		months = monthsPerYear
+	case unitQuarter:
+		months = monthsPerQuarter
+	default:
+		if roundUp {
+			return endOfUnit(t, unit, p.weekStart())
+		}
+
+		return startOfUnit(t, unit, p.weekStart())
+	}
+
+	monthsIn := (int(t.Month()) - int(p.fiscalYearStartMonth) + monthsPerYear) % months
+	start := time.Date(t.Year(), t.Month()-time.Month(monthsIn), 1, 0, 0, 0, 0, t.Location())
+
+	if roundUp {
+		return start.AddDate(0, months, 0).Add(-time.Millisecond)
+	}
+
+	return start
+}
+
+// grafanaDateMath returns the date math syntax of Grafana.
+func (p *Parser) grafanaDateMath() dateMathSyntax {
+	return dateMathSyntax{
+		units:        "yQMwdhms",
+		fiscal:       true,
+		maxNumberEnd: grafanaMaxNumberEnd,
+		weekStart:    p.weekStart(),
+	}
+}
@@ -0,0 +1,148 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func endOfDay(year int, month time.Month, day int) time.Time {
+	return midnight(year, month, day).AddDate(0, 0, 1).Add(-time.Millisecond)
+}
+
+func TestParseBounds_Grafana(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	parser := NewParser(WithDialect(GrafanaDialect), WithClock(fixedTime))
+
+	tests := []struct {
+		from  string
+		to    string
+		start time.Time
+		end   time.Time
+	}{
+		{from: "now-6h", to: "now", start: time.Date(2025, 12, 10, 9, 30, 45, 0, time.UTC), end: fixedTime()},
+		{from: "now/d", to: "now/d", start: midnight(2025, 12, 10), end: endOfDay(2025, 12, 10)},
+		{from: "now-1d/d", to: "now-1d/d", start: midnight(2025, 12, 9), end: endOfDay(2025, 12, 9)},
+		{from: "now/w", to: "now/w", start: midnight(2025, 12, 8), end: endOfDay(2025, 12, 14)},
+		{from: "now-1w/w", to: "now-1w/w", start: midnight(2025, 12, 1), end: endOfDay(2025, 12, 7)},
+		{from: "now/M", to: "now/M", start: midnight(2025, 12, 1), end: endOfDay(2025, 12, 31)},
+		{from: "now/Q", to: "now/Q", start: midnight(2025, 10, 1), end: endOfDay(2025, 12, 31)},
+		{from: "now/y", to: "now/y", start: midnight(2025, 1, 1), end: endOfDay(2025, 12, 31)},
+		{from: "now-5m", to: "now+5m", start: fixedTime().Add(-5 * time.Minute), end: fixedTime().Add(5 * time.Minute)},
+		{from: "now-2y/y", to: "now-1y/y", start: midnight(2023, 1, 1), end: endOfDay(2024, 12, 31)},
+		{from: "now/d+8h", to: "now/d+17h", start: time.Date(2025, 12, 10, 8, 0, 0, 0, time.UTC), end: time.Date(2025, 12, 11, 16, 59, 59, 999000000, time.UTC)},
+		{from: "2025-03-31||-1M", to: "2025-03-31||+1M/d", start: midnight(2025, 2, 28), end: endOfDay(2025, 4, 30)},
+		{from: "2025-12-01T00:00:00Z", to: "2025-12-01T00:00:00Z||+1w", start: midnight(2025, 12, 1), end: midnight(2025, 12, 8)},
+		{from: "1416434697000", to: "now", start: time.UnixMilli(1416434697000), end: fixedTime()},
+		{from: "now-1h-1h-1h", to: "now", start: fixedTime().Add(-3 * time.Hour), end: fixedTime()},
+		{from: "now-10s", to: "now/s", start: fixedTime().Add(-10 * time.Second), end: fixedTime().Add(999 * time.Millisecond)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+" to "+tt.to, func(t *testing.T) {
+			r, err := parser.ParseBounds(tt.from, tt.to)
+			require.NoError(t, err)
+			assert.True(t, tt.start.Equal(r.Start), "expected start %v, got %v", tt.start, r.Start)
+			assert.True(t, tt.end.Equal(r.End), "expected end %v, got %v", tt.end, r.End)
+		})
+	}
+
+	// Grafana stops reading amounts past index 10 of the whole math string.
+	invalid := []string{
+		"now/2d", "now-6x", "now-", "now-1H", "yesterday", "now 6h", "", "now-12345678901d", "now-1h-1h-1h-1m",
+	}
+
+	for _, input := range invalid {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parser.ParseBounds(input, "now")
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidStartTime))
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+}
+
+func TestParser_GrafanaOptions(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	t.Run("week start", func(t *testing.T) {
+		parser := NewParser(WithDialect(GrafanaDialect), WithClock(fixedTime), WithWeekStart(time.Sunday))
+
+		r, err := parser.ParseBounds("now/w", "now/w")
+		require.NoError(t, err)
+		assert.Equal(t, midnight(2025, 12, 7), r.Start)
+		assert.Equal(t, endOfDay(2025, 12, 13), r.End)
+	})
+
+	t.Run("location", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Skip("time zone data unavailable")
+		}
+
+		parser := NewParser(WithDialect(GrafanaDialect), WithClock(fixedTime), WithLocation(loc))
+
+		r, err := parser.ParseBounds("now/d", "now/d")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 12, 10, 0, 0, 0, 0, loc), r.Start)
+		assert.Equal(t, time.Date(2025, 12, 10, 23, 59, 59, 999000000, loc), r.End)
+	})
+
+	t.Run("fiscal year", func(t *testing.T) {
+		parser := NewParser(WithDialect(GrafanaDialect), WithClock(fixedTime), WithFiscalYearStart(time.October))
+
+		r, err := parser.ParseBounds("now/fy", "now/fy")
+		require.NoError(t, err)
+		assert.Equal(t, midnight(2025, 10, 1), r.Start)
+		assert.Equal(t, endOfDay(2026, 9, 30), r.End)
+
+		r, err = parser.ParseBounds("now-1fQ/fQ", "now-1fQ/fQ")
+		require.NoError(t, err)
+		assert.Equal(t, midnight(2025, 7, 1), r.Start)
+		assert.Equal(t, endOfDay(2025, 9, 30), r.End)
+	})
+
+	t.Run("slash is rounding, not a range", func(t *testing.T) {
+		parser := NewParser(WithDialect(GrafanaDialect), WithClock(fixedTime))
+
+		r, err := parser.ParseRange("now-1d/d")
+		require.NoError(t, err)
+		assert.Equal(t, Range{Start: midnight(2025, 12, 9), End: midnight(2025, 12, 9)}, r)
+
+		result, err := parser.ParseTime("now-1d/d", fixedTime(), time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, midnight(2025, 12, 9), result)
+	})
+}
+
+func TestParseBounds_Default(t *testing.T) {
+	r, err := NewParser(WithClock(fixedTime)).ParseBounds("Q3 2025", "Q4 2025")
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: midnight(2025, 7, 1), End: midnight(2026, 1, 1)}, r)
+
+	_, err = NewParser(WithClock(fixedTime)).ParseBounds("1h", "2h")
+	assert.True(t, errors.Is(err, ErrEndBeforeStart))
+
+	pairs := [][2]string{
+		{"2025-12-10T00:00Z", "P1D"},
+		{"PT2H", "2025-12-10T15:00Z"},
+		{"yesterday", "+2h"},
+		{"last monday", "now"},
+		{"", "1416434697"},
+	}
+
+	for _, pair := range pairs {
+		t.Run(pair[0]+" "+pair[1], func(t *testing.T) {
+			parser := NewParser(WithClock(fixedTime))
+
+			expected, err := parser.ParseRange(pair[0] + "/" + pair[1])
+			require.NoError(t, err)
+
+			r, err := parser.ParseBounds(pair[0], pair[1])
+			require.NoError(t, err)
+			assert.Equal(t, expected, r)
+		})
+	}
+}
@@ -0,0 +1,401 @@
+package friendlytime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Graphite's fixed lengths for month and year offsets, in days.
+	graphiteDaysPerMonth = 30
+	graphiteDaysPerYear  = 365
+
+	// Graphite's "HH:MM_YYYYMMDD" format once separators are removed.
+	graphiteTimeDateFormat = "15:0420060102"
+	graphiteDateFormat     = "20060102"
+	graphiteYearEnd        = 4 // length of "YYYY" in "YYYYMMDD"
+	graphiteMonthEnd       = 6 // length of "YYYYMM" in "YYYYMMDD"
+	graphiteMinYear        = 1900
+	graphiteEpochYear      = 1970
+	graphiteMaxClockDigits = 3
+	graphiteMinuteEnd      = 3 // length of ":MM"
+	hoursPerHalfDay        = 12
+
+	// Graphite's default from and until.
+	graphiteDefaultFrom = "-1d"
+)
+
+// getGraphiteWeekdays returns Graphite's weekday prefixes, indexed by time.Weekday.
+func getGraphiteWeekdays() []string {
+	return []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+}
+
+// parseGraphite parses a Graphite from or until value the way the Graphite
+// render API does. It is a time reference followed by an optional offset:
+//
+//   - references: "now", "yesterday", "today", "tomorrow", "noon",
+//     "midnight", "teatime", "HH:MM", "6am", weekday names, "MM/DD/YY",
+//     "YYYYMMDD" and month names with a day ("dec10"), which combine as in
+//     "noon tomorrow" or "15:30 20251210"
+//   - offsets: "-1d", "+3h", "-2w1d", with units s, min, h, d, w, mon and y,
+//     where months are 30 days and years 365 days and the sign applies to
+//     every term
+//
+// Spaces, commas and underscores are ignored, so "HH:MM_YYYYMMDD" works. A
+// bare number is a Unix timestamp unless it reads as YYYYMMDD. An empty
+// from is "-1d" and an empty until is now, as in Graphite.
+func (p *Parser) parseGraphite(timeStr string, now time.Time, isUntil bool) (time.Time, error) {
+	s := strings.NewReplacer("_", "", ",", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(timeStr)))
+
+	if s == "" {
+		if isUntil {
+			return now, nil
+		}
+
+		s = graphiteDefaultFrom
+	}
+
+	if isAllDigits(s) && !isGraphiteDate(s) {
+		seconds, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: invalid Graphite timestamp %q", ErrInvalidTimeFormat, timeStr)
+		}
+
+		return p.localize(time.Unix(seconds, 0)), nil
+	}
+
+	if strings.Contains(s, ":") && len(s) == len(graphiteTimeDateFormat) {
+		t, err := time.ParseInLocation(graphiteTimeDateFormat, s, now.Location())
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: invalid Graphite time %q", ErrInvalidTimeFormat, timeStr)
+		}
+
+		return t, nil
+	}
+
+	ref, offset := s, ""
+	if i := strings.Index(s, "+"); i >= 0 {
+		ref, offset = s[:i], s[i:]
+	} else if i := strings.Index(s, "-"); i >= 0 {
+		ref, offset = s[:i], s[i:]
+	}
+
+	t, err := parseGraphiteReference(ref, now)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w in %q", err, timeStr)
+	}
+
+	t, err = applyGraphiteOffset(offset, t)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w in %q", ErrInvalidTimeFormat, err, timeStr)
+	}
+
+	return t, nil
+}
+
+// parseGraphiteReference resolves the time reference part of a Graphite time:
+// an optional time of day followed by an optional day.
+func parseGraphiteReference(ref string, now time.Time) (time.Time, error) {
+	if ref == "" || ref == "now" {
+		return now, nil
+	}
+
+	hour, minute, day, err := parseGraphiteTimeOfDay(ref)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	refDate := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, now.Location())
+
+	return resolveGraphiteDay(day, ref, refDate)
+}
+
+// parseGraphiteTimeOfDay parses the time of day a Graphite time reference
+// starts with: "HH:MM" with an optional "am" or "pm", an hour such as "6am",
+// or "noon", "midnight" or "teatime". It returns midnight if there is none,
+// and the rest of the reference.
+func parseGraphiteTimeOfDay(ref string) (int, int, string, error) {
+	rawRef := ref
+
+	hour, minute, ref, err := parseGraphiteClock(ref)
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	for _, meridiem := range []string{"am", "pm"} {
+		if i := strings.Index(ref, meridiem); i > 0 && i < graphiteMaxClockDigits {
+			h, err := strconv.Atoi(ref[:i])
+			if err != nil {
+				return 0, 0, "", fmt.Errorf("%w: invalid hour %q", ErrInvalidTimeFormat, rawRef)
+			}
+
+			hour = h
+			if meridiem == "pm" {
+				hour = (h + hoursPerHalfDay) % hoursPerDay
+			}
+
+			ref = ref[i+len(meridiem):]
+		}
+	}
+
+	for _, keyword := range []struct {
+		name         string
+		hour, minute int
+	}{
+		{name: "noon", hour: 12},
+		{name: "midnight"},
+		{name: "teatime", hour: 16},
+	} {
+		if rest, ok := strings.CutPrefix(ref, keyword.name); ok {
+			hour, minute, ref = keyword.hour, keyword.minute, rest
+
+			break
+		}
+	}
+
+	if hour >= hoursPerDay || minute >= minutesPerHour {
+		return 0, 0, "", fmt.Errorf("%w: invalid time of day %q", ErrInvalidTimeFormat, rawRef)
+	}
+
+	return hour, minute, ref, nil
+}
+
+// parseGraphiteClock parses an "HH:MM" time of day with an optional "am" or
+// "pm" at the start of ref, returning it and the rest of ref. Without a
+// clock time it returns midnight and ref unchanged.
+func parseGraphiteClock(ref string) (int, int, string, error) {
+	i := strings.Index(ref, ":")
+	if i <= 0 || i >= graphiteMaxClockDigits {
+		return 0, 0, ref, nil
+	}
+
+	hour, err := strconv.Atoi(ref[:i])
+	if err != nil || len(ref) < i+graphiteMinuteEnd {
+		return 0, 0, "", fmt.Errorf("%w: invalid time of day %q", ErrInvalidTimeFormat, ref)
+	}
+
+	minute, err := strconv.Atoi(ref[i+1 : i+graphiteMinuteEnd])
+	if err != nil {
+		return 0, 0, "", fmt.Errorf("%w: invalid time of day %q", ErrInvalidTimeFormat, ref)
+	}
+
+	rest := ref[i+graphiteMinuteEnd:]
+
+	if afterAM, ok := strings.CutPrefix(rest, "am"); ok {
+		rest = afterAM
+	} else if afterPM, ok := strings.CutPrefix(rest, "pm"); ok {
+		hour = (hour + hoursPerHalfDay) % hoursPerDay
+		rest = afterPM
+	}
+
+	return hour, minute, rest, nil
+}
+
+// resolveGraphiteDay applies the day part of a Graphite time reference to refDate.
+func resolveGraphiteDay(ref, rawRef string, refDate time.Time) (time.Time, error) {
+	switch {
+	case ref == "":
+		return refDate, nil
+	case ref == "today":
+		return refDate, nil
+	case ref == "yesterday":
+		return refDate.AddDate(0, 0, -1), nil
+	case ref == "tomorrow":
+		return refDate.AddDate(0, 0, 1), nil
+	case strings.Count(ref, "/") == 2:
+		return parseGraphiteSlashDate(ref, rawRef, refDate)
+	case len(ref) == len(graphiteDateFormat) && isAllDigits(ref):
+		year, month, day := splitGraphiteDate(ref)
+
+		return replaceDate(refDate, year, time.Month(month), day, rawRef)
+	}
+
+	if month, ok := parseMonth(ref[:min(len(ref), 3)]); ok && len(ref) >= 3 {
+		digits := ref[max(len(ref)-2, 0):]
+		if !isDigit(digits[0]) {
+			digits = digits[1:]
+		}
+
+		day, err := strconv.Atoi(digits)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: day of month required after month name in %q", ErrInvalidTimeFormat, rawRef)
+		}
+
+		return replaceDate(refDate, refDate.Year(), month, day, rawRef)
+	}
+
+	for weekday, name := range getGraphiteWeekdays() {
+		if strings.HasPrefix(ref, name) {
+			dayOffset := (int(refDate.Weekday()) - weekday + daysPerWeek) % daysPerWeek
+
+			return refDate.AddDate(0, 0, -dayOffset), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: unknown day reference %q", ErrInvalidTimeFormat, rawRef)
+}
+
+// parseGraphiteSlashDate parses a "MM/DD/YY" or "MM/DD/YYYY" day reference.
+func parseGraphiteSlashDate(ref, rawRef string, refDate time.Time) (time.Time, error) {
+	parts := strings.Split(ref, "/")
+
+	month, errMonth := strconv.Atoi(parts[0])
+	day, errDay := strconv.Atoi(parts[1])
+	year, errYear := strconv.Atoi(parts[2])
+
+	if errMonth != nil || errDay != nil || errYear != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid date %q", ErrInvalidTimeFormat, rawRef)
+	}
+
+	if year < graphiteMinYear {
+		year += graphiteMinYear
+	}
+
+	if year < graphiteEpochYear {
+		year += yearsPerCentury
+	}
+
+	return replaceDate(refDate, year, time.Month(month), day, rawRef)
+}
+
+// replaceDate sets the date of t, failing for dates that don't exist rather
+// than normalizing them.
+func replaceDate(t time.Time, year int, month time.Month, day int, rawRef string) (time.Time, error) {
+	if month < time.January || month > time.December || day < 1 || day > daysIn(year, month) {
+		return time.Time{}, fmt.Errorf("%w: %w: %q", ErrInvalidTimeFormat, ErrNonexistentDate, rawRef)
+	}
+
+	return time.Date(year, month, day, t.Hour(), t.Minute(), 0, 0, t.Location()), nil
+}
+
+// applyGraphiteOffset applies a Graphite offset such as "-1d" or "+2h30min" to t.
+func applyGraphiteOffset(offset string, t time.Time) (time.Time, error) {
+	if offset == "" {
+		return t, nil
+	}
+
+	sign := 1
+
+	switch offset[0] {
+	case '+':
+		offset = offset[1:]
+	case '-':
+		sign = -1
+		offset = offset[1:]
+	}
+
+	for offset != "" {
+		digits, rest := cutDigits(offset)
+		name, tail := cutWord(rest)
+
+		num, err := strconv.Atoi(digits)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: invalid offset %q", ErrInvalidDuration, offset)
+		}
+
+		num *= sign
+
+		switch {
+		case strings.HasPrefix(name, "s"):
+			t = t.Add(time.Duration(num) * time.Second)
+		case strings.HasPrefix(name, "min"):
+			t = t.Add(time.Duration(num) * time.Minute)
+		case strings.HasPrefix(name, "h"):
+			t = t.Add(time.Duration(num) * time.Hour)
+		case strings.HasPrefix(name, "d"):
+			t = t.AddDate(0, 0, num)
+		case strings.HasPrefix(name, "w"):
+			t = t.AddDate(0, 0, num*daysPerWeek)
+		case strings.HasPrefix(name, "mon"):
+			t = t.AddDate(0, 0, num*graphiteDaysPerMonth)
+		case strings.HasPrefix(name, "y"):
+			t = t.AddDate(0, 0, num*graphiteDaysPerYear)
+		default:
+			return time.Time{}, fmt.Errorf("%w: invalid offset unit %q", ErrInvalidDuration, name)
+		}
+
+		offset = tail
+	}
+
+	return t, nil
+}
+
+// isGraphiteDate reports whether an all-digit string reads as YYYYMMDD
+// rather than a Unix timestamp, by Graphite's rule.
+func isGraphiteDate(s string) bool {
+	if len(s) != len(graphiteDateFormat) {
+		return false
+	}
+
+	year, month, day := splitGraphiteDate(s)
+
+	return year > graphiteMinYear && month <= monthsPerYear && day <= maxDayOfMonth
+}
+
+// splitGraphiteDate splits an all-digit "YYYYMMDD" string into its year,
+// month and day.
+func splitGraphiteDate(s string) (int, int, int) {
+	year, _ := strconv.Atoi(s[:graphiteYearEnd])
+	month, _ := strconv.Atoi(s[graphiteYearEnd:graphiteMonthEnd])
+	day, _ := strconv.Atoi(s[graphiteMonthEnd:])
+
+	return year, month, day
+}
+
+// isAllDigits reports whether s is a non-empty run of ASCII digits.
+func isAllDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := range len(s) {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cutDigits splits s into its leading run of ASCII digits and the remainder.
+func cutDigits(s string) (string, string) {
+	end := 0
+	for end < len(s) && isDigit(s[end]) {
+		end++
+	}
+
+	return s[:end], s[end:]
+}
+
+// parseGraphitePair parses "from=<time>&until=<time>", in either order and
+// with either side optional, into a Range. Missing sides take Graphite's
+// defaults.
+func (p *Parser) parseGraphitePair(timeRange string, now time.Time) (Range, bool, error) {
+	if !strings.Contains(timeRange, "=") {
+		return Range{}, false, nil
+	}
+
+	var from, until string
+
+	for _, arg := range strings.Split(timeRange, "&") {
+		key, value, found := strings.Cut(arg, "=")
+		if !found {
+			return Range{}, true, fmt.Errorf("%w: missing value in %q", ErrInvalidTimeRange, arg)
+		}
+
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "from":
+			from = value
+		case "until":
+			until = value
+		default:
+			return Range{}, true, fmt.Errorf("%w: unknown Graphite time argument %q", ErrInvalidTimeRange, key)
+		}
+	}
+
+	r, err := p.parseBoundsAt(from, until, now)
+
+	return r, true, err
+}
@@ -0,0 +1,130 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime_Graphite(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	parser := NewParser(WithDialect(GraphiteDialect))
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "now", expected: now},
+		{input: "-1d", expected: now.AddDate(0, 0, -1)},
+		{input: "now-1d", expected: now.AddDate(0, 0, -1)},
+		{input: "+3h", expected: now.Add(3 * time.Hour)},
+		{input: "-5s", expected: now.Add(-5 * time.Second)},
+		{input: "-1h30min", expected: now.Add(-90 * time.Minute)},
+		{input: "-2weeks", expected: now.AddDate(0, 0, -14)},
+		{input: "-1mon", expected: now.AddDate(0, 0, -30)},
+		{input: "-1y", expected: now.AddDate(0, 0, -365)},
+		{input: "today", expected: midnight(2025, 12, 10)},
+		{input: "yesterday", expected: midnight(2025, 12, 9)},
+		{input: "tomorrow", expected: midnight(2025, 12, 11)},
+		{input: "midnight yesterday", expected: midnight(2025, 12, 9)},
+		{input: "noon tomorrow", expected: time.Date(2025, 12, 11, 12, 0, 0, 0, time.UTC)},
+		{input: "teatime", expected: time.Date(2025, 12, 10, 16, 0, 0, 0, time.UTC)},
+		{input: "6pm tomorrow", expected: time.Date(2025, 12, 11, 18, 0, 0, 0, time.UTC)},
+		{input: "9:30am dec 11", expected: time.Date(2025, 12, 11, 9, 30, 0, 0, time.UTC)},
+		{input: "10:15pm yesterday", expected: time.Date(2025, 12, 9, 22, 15, 0, 0, time.UTC)},
+		{input: "midnight yesterday+6h", expected: time.Date(2025, 12, 9, 6, 0, 0, 0, time.UTC)},
+		{input: "20251210", expected: midnight(2025, 12, 10)},
+		{input: "15:30_20251210", expected: time.Date(2025, 12, 10, 15, 30, 0, 0, time.UTC)},
+		{input: "noon 20251201", expected: time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC)},
+		{input: "12/10/25", expected: midnight(2025, 12, 10)},
+		{input: "12/10/2025", expected: midnight(2025, 12, 10)},
+		{input: "jan1", expected: midnight(2025, 1, 1)},
+		{input: "monday", expected: midnight(2025, 12, 8)},
+		{input: "wednesday", expected: midnight(2025, 12, 10)},
+		{input: "thu", expected: midnight(2025, 12, 4)},
+		{input: "1416434697", expected: time.Unix(1416434697, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := parser.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+
+	for _, input := range []string{"-1m", "-1x", "garbage", "feb30", "25:00", "noon yesterday garbage", "dec"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parser.ParseTime(input, now, time.Time{})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+
+	t.Run("error kinds", func(t *testing.T) {
+		_, err := parser.ParseTime("-1x", now, time.Time{})
+		assert.True(t, errors.Is(err, ErrInvalidDuration))
+
+		_, err = parser.ParseTime("feb30", now, time.Time{})
+		assert.True(t, errors.Is(err, ErrNonexistentDate))
+	})
+}
+
+func TestParseBounds_Graphite(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	parser := NewParser(WithDialect(GraphiteDialect), WithClock(fixedTime))
+
+	r, err := parser.ParseBounds("", "")
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: fixedTime().AddDate(0, 0, -1), End: fixedTime()}, r)
+
+	r, err = parser.ParseBounds("midnight yesterday", "midnight")
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: midnight(2025, 12, 9), End: midnight(2025, 12, 10)}, r)
+
+	_, err = parser.ParseBounds("now", "-1d")
+	assert.True(t, errors.Is(err, ErrEndBeforeStart))
+}
+
+func TestParseRange_GraphitePairs(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	parser := NewParser(WithDialect(GraphiteDialect), WithClock(fixedTime))
+
+	tests := []struct {
+		input string
+		start time.Time
+		end   time.Time
+	}{
+		{input: "from=-7d&until=now", start: fixedTime().AddDate(0, 0, -7), end: fixedTime()},
+		{input: "until=midnight&from=midnight yesterday", start: midnight(2025, 12, 9), end: midnight(2025, 12, 10)},
+		{input: "until=-1h", start: fixedTime().AddDate(0, 0, -1), end: fixedTime().Add(-time.Hour)},
+		{input: "from=20251201", start: midnight(2025, 12, 1), end: fixedTime()},
+		{input: "noon yesterday", start: time.Date(2025, 12, 9, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := parser.ParseRange(tt.input)
+			require.NoError(t, err)
+
+			end := tt.end
+			if end.IsZero() {
+				end = tt.start
+			}
+
+			assert.True(t, tt.start.Equal(r.Start), "expected start %v, got %v", tt.start, r.Start)
+			assert.True(t, end.Equal(r.End), "expected end %v, got %v", end, r.End)
+		})
+	}
+
+	for _, input := range []string{"from=-1d&to=now", "from=-1x", "from"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parser.ParseRange(input)
+			require.Error(t, err)
+		})
+	}
+}
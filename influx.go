@@ -0,0 +1,368 @@
+package friendlytime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// influxUnit is a unit of InfluxQL and Flux duration literals.
+type influxUnit struct {
+	name   string
+	length time.Duration // exact length, unless months is set
+	months int           // calendar months, for Flux's mo and y
+}
+
+// getInfluxQLUnits returns the units of InfluxQL duration literals.
+func getInfluxQLUnits() []influxUnit {
+	return []influxUnit{
+		{name: "ns", length: time.Nanosecond},
+		{name: "u", length: time.Microsecond},
+		{name: "µ", length: time.Microsecond},
+		{name: "ms", length: time.Millisecond},
+		{name: "s", length: time.Second},
+		{name: "m", length: time.Minute},
+		{name: "h", length: time.Hour},
+		{name: "d", length: hoursPerDay * time.Hour},
+		{name: "w", length: daysPerWeek * hoursPerDay * time.Hour},
+	}
+}
+
+// getFluxUnits returns the units of Flux duration literals.
+func getFluxUnits() []influxUnit {
+	return []influxUnit{
+		{name: "ns", length: time.Nanosecond},
+		{name: "us", length: time.Microsecond},
+		{name: "µs", length: time.Microsecond},
+		{name: "ms", length: time.Millisecond},
+		{name: "s", length: time.Second},
+		{name: "m", length: time.Minute},
+		{name: "h", length: time.Hour},
+		{name: "d", length: hoursPerDay * time.Hour},
+		{name: "w", length: daysPerWeek * hoursPerDay * time.Hour},
+		{name: "mo", months: 1},
+		{name: "y", months: monthsPerYear},
+	}
+}
+
+// getInfluxTimeFormats returns the layouts of InfluxQL time string literals
+// and Flux date and time literals. Times without a zone are UTC.
+func getInfluxTimeFormats() []string {
+	return []string{
+		time.RFC3339Nano,
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02",
+	}
+}
+
+// parseInfluxTime parses an RFC 3339 time or date, in UTC unless it has a zone.
+func parseInfluxTime(s string) (time.Time, bool) {
+	for _, layout := range getInfluxTimeFormats() {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// parseInfluxDuration parses a duration literal such as "1h30m" or "1mo2w":
+// whole amounts followed by units, repeated. Only Flux's mo and y units
+// count calendar months; every other unit is exact.
+func parseInfluxDuration(s string, units []influxUnit) (span, error) {
+	if s == "" {
+		return span{}, fmt.Errorf("%w: empty duration literal", ErrInvalidDuration)
+	}
+
+	var result span
+
+	for rest := s; rest != ""; {
+		digits, tail := cutDigits(rest)
+		name, tail := cutWord(tail)
+
+		n, err := strconv.ParseInt(digits, 10, 64)
+		if err != nil || name == "" {
+			return span{}, fmt.Errorf("%w: invalid duration literal %q", ErrInvalidDuration, s)
+		}
+
+		unit, ok := lookupInfluxUnit(name, units)
+		if !ok {
+			return span{}, fmt.Errorf("%w: unknown unit %q in %q", ErrInvalidDuration, name, s)
+		}
+
+		if unit.months > 0 {
+			result.months += int(n) * unit.months
+		} else {
+			result.exact += time.Duration(n) * unit.length
+		}
+
+		rest = tail
+	}
+
+	return result, nil
+}
+
+// lookupInfluxUnit finds the unit with the given name.
+func lookupInfluxUnit(name string, units []influxUnit) (influxUnit, bool) {
+	for _, unit := range units {
+		if unit.name == name {
+			return unit, true
+		}
+	}
+
+	return influxUnit{}, false
+}
+
+// addInfluxDuration adds a duration to t, or subtracts it when sign is
+// negative. Months are added first and clamp to the end of the month.
+func addInfluxDuration(t time.Time, d span, sign int) time.Time {
+	return addMonthsClamped(t, sign*d.months).Add(time.Duration(sign) * d.exact)
+}
+
+// parseInfluxQL parses an InfluxQL time expression, as on the right of
+// "time >" in a WHERE clause: a time followed by any number of "+ <duration>"
+// and "- <duration>" terms, as in "now() - 2d + 30m". The time is now(), a
+// quoted RFC 3339 string such as '2025-12-10T15:00:00Z', an integer of
+// nanoseconds since the epoch, or a duration literal since the epoch such
+// as 1416434697s. Durations use InfluxQL units: ns, u or µ, ms, s, m, h, d
+// and w. An empty expression is an open side of a range.
+func (p *Parser) parseInfluxQL(timeStr string, now time.Time) (time.Time, error) {
+	s := removeUnquotedSpaces(strings.TrimSpace(timeStr))
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	t, rest, err := cutInfluxQLTime(s, timeStr, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for rest != "" {
+		sign := 1
+
+		switch rest[0] {
+		case '+':
+		case '-':
+			sign = -1
+		default:
+			return time.Time{}, fmt.Errorf("%w: unexpected %q in %q", ErrInvalidTimeFormat, rest, timeStr)
+		}
+
+		rest = rest[1:]
+
+		end := strings.IndexAny(rest, "+-")
+		if end < 0 {
+			end = len(rest)
+		}
+
+		d, err := parseInfluxDuration(rest[:end], getInfluxQLUnits())
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %w", ErrInvalidTimeFormat, err)
+		}
+
+		t = addInfluxDuration(t, d, sign)
+		rest = rest[end:]
+	}
+
+	return p.localize(t), nil
+}
+
+// cutInfluxQLTime parses the time at the start of an InfluxQL time
+// expression with its spaces removed, and returns the remainder.
+func cutInfluxQLTime(s, timeStr string, now time.Time) (time.Time, string, error) {
+	if rest, ok := strings.CutPrefix(strings.ToLower(s), "now()"); ok {
+		return now, rest, nil
+	}
+
+	if strings.HasPrefix(s, "'") {
+		end := strings.IndexByte(s[1:], '\'')
+		if end < 0 {
+			return time.Time{}, "", fmt.Errorf("%w: unterminated string in %q", ErrInvalidTimeFormat, timeStr)
+		}
+
+		t, ok := parseInfluxTime(s[1 : end+1])
+		if !ok {
+			return time.Time{}, "", fmt.Errorf("%w: invalid time string in %q", ErrInvalidTimeFormat, timeStr)
+		}
+
+		return t, s[end+2:], nil
+	}
+
+	end := strings.IndexAny(s[1:], "+-") + 1
+	if end == 0 {
+		end = len(s)
+	}
+
+	literal := s[:end]
+
+	if nanos, err := strconv.ParseInt(literal, 10, 64); err == nil {
+		return time.Unix(0, nanos).UTC(), s[end:], nil
+	}
+
+	d, err := parseInfluxDuration(literal, getInfluxQLUnits())
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("%w: %q is not an InfluxQL time", ErrInvalidTimeFormat, timeStr)
+	}
+
+	return time.Unix(0, 0).UTC().Add(d.exact), s[end:], nil
+}
+
+// parseFlux parses a Flux time, as passed to the start and stop parameters
+// of range(): now(), a duration literal relative to now such as "-1h" or
+// "-1mo2w", an RFC 3339 date and time or date literal, or an integer of
+// Unix seconds. Durations use Flux units: ns, us or µs, ms, s, m, h, d, w,
+// mo and y, where mo and y are calendar months and clamp to the end of
+// the month.
+//
+// An empty start is an open side of a range, and an empty stop is now, as
+// Flux defaults stop to now().
+func (p *Parser) parseFlux(timeStr string, now time.Time, isStop bool) (time.Time, error) {
+	s := strings.TrimSpace(timeStr)
+
+	switch s {
+	case "":
+		if isStop {
+			return now, nil
+		}
+
+		return time.Time{}, nil
+	case "now()":
+		return now, nil
+	}
+
+	if t, ok := parseInfluxTime(s); ok {
+		return p.localize(t), nil
+	}
+
+	if seconds, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return p.localize(time.Unix(seconds, 0)), nil
+	}
+
+	sign := 1
+	if rest, ok := strings.CutPrefix(s, "-"); ok {
+		sign, s = -1, rest
+	}
+
+	d, err := parseInfluxDuration(s, getFluxUnits())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %q is not a Flux time: %w", ErrInvalidTimeFormat, timeStr, err)
+	}
+
+	return addInfluxDuration(now, d, sign), nil
+}
+
+// parseFluxRange parses the parameters of a Flux range() call, such as
+// "range(start: -1h, stop: now())" or "start: -1h", into a Range. The
+// "range(" call and a leading "|>" are optional.
+func (p *Parser) parseFluxRange(timeRange string, now time.Time) (Range, bool, error) {
+	s := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(timeRange), "|>"))
+	if args, ok := strings.CutPrefix(s, "range("); ok {
+		s, ok = strings.CutSuffix(args, ")")
+		if !ok {
+			return Range{}, true, fmt.Errorf("%w: unterminated range() in %q", ErrInvalidTimeRange, timeRange)
+		}
+	}
+
+	if !strings.Contains(s, ":") || strings.HasPrefix(s, "-") || isDigit(s[0]) {
+		return Range{}, false, nil
+	}
+
+	var start, stop string
+
+	for _, arg := range strings.Split(s, ",") {
+		key, value, found := strings.Cut(arg, ":")
+		if !found {
+			return Range{}, true, fmt.Errorf("%w: missing value in %q", ErrInvalidTimeRange, arg)
+		}
+
+		switch strings.TrimSpace(key) {
+		case "start":
+			start = value
+		case "stop":
+			stop = value
+		default:
+			return Range{}, true, fmt.Errorf("%w: unknown range() parameter %q", ErrInvalidTimeRange, key)
+		}
+	}
+
+	if strings.TrimSpace(start) == "" {
+		return Range{}, true, fmt.Errorf("%w: range() requires start", ErrInvalidTimeRange)
+	}
+
+	r, err := p.parseBoundsAt(start, stop, now)
+
+	return r, true, err
+}
+
+// parseInfluxQLRange parses InfluxQL time conditions such as
+// "time >= now() - 1h AND time < now()" into a Range. Conditions with > and
+// >= set the start, and < and <= set the end.
+func (p *Parser) parseInfluxQLRange(timeRange string, now time.Time) (Range, bool, error) {
+	s := strings.TrimSpace(timeRange)
+	if !strings.HasPrefix(strings.ToLower(s), "time") {
+		return Range{}, false, nil
+	}
+
+	var start, end string
+
+	for _, condition := range splitInfluxQLConditions(s) {
+		condition = strings.TrimSpace(condition)
+		ok := len(condition) > len("time") && strings.EqualFold(condition[:len("time")], "time")
+		rest := strings.TrimSpace(condition[min(len(condition), len("time")):])
+
+		switch {
+		case !ok:
+			return Range{}, true, fmt.Errorf("%w: unexpected condition %q", ErrInvalidTimeRange, condition)
+		case strings.HasPrefix(rest, ">="):
+			start = rest[len(">="):]
+		case strings.HasPrefix(rest, ">"):
+			start = rest[len(">"):]
+		case strings.HasPrefix(rest, "<="):
+			end = rest[len("<="):]
+		case strings.HasPrefix(rest, "<"):
+			end = rest[len("<"):]
+		default:
+			return Range{}, true, fmt.Errorf("%w: unexpected condition %q", ErrInvalidTimeRange, condition)
+		}
+	}
+
+	r, err := p.parseBoundsAt(start, end, now)
+
+	return r, true, err
+}
+
+// splitInfluxQLConditions splits a WHERE clause on AND, in any case.
+func splitInfluxQLConditions(s string) []string {
+	var conditions []string
+
+	for {
+		i := strings.Index(strings.ToLower(s), " and ")
+		if i < 0 {
+			return append(conditions, s)
+		}
+
+		conditions = append(conditions, s[:i])
+		s = s[i+len(" and "):]
+	}
+}
+
+// removeUnquotedSpaces removes the whitespace of s outside single-quoted strings.
+func removeUnquotedSpaces(s string) string {
+	var b strings.Builder
+
+	quoted := false
+
+	for _, r := range s {
+		if r == '\'' {
+			quoted = !quoted
+		}
+
+		if quoted || !unicode.IsSpace(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,158 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime_InfluxQL(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	parser := NewParser(WithDialect(InfluxQLDialect))
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "now()", expected: now},
+		{input: "now() - 1h", expected: now.Add(-time.Hour)},
+		{input: "now() - 2d + 30m", expected: now.Add(-48*time.Hour + 30*time.Minute)},
+		{input: "now()-1w", expected: now.Add(-7 * 24 * time.Hour)},
+		{input: "now() - 1h30m", expected: now.Add(-90 * time.Minute)},
+		{input: "now() - 500ms", expected: now.Add(-500 * time.Millisecond)},
+		{input: "now() - 10u", expected: now.Add(-10 * time.Microsecond)},
+		{input: "'2025-12-10T15:00:00Z'", expected: time.Date(2025, 12, 10, 15, 0, 0, 0, time.UTC)},
+		{input: "'2025-12-10 15:00:00'", expected: time.Date(2025, 12, 10, 15, 0, 0, 0, time.UTC)},
+		{input: "'2025-12-10'", expected: midnight(2025, 12, 10)},
+		{input: "'2025-12-10T00:00:00Z' + 1h", expected: time.Date(2025, 12, 10, 1, 0, 0, 0, time.UTC)},
+		{input: "1416434697000000000", expected: time.Unix(1416434697, 0)},
+		{input: "1416434697s", expected: time.Unix(1416434697, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := parser.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+
+	for _, input := range []string{"now() - 1mo", "now() * 2", "'garbage'", "yesterday", "now() - 1.5h", "'2025-12-10"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parser.ParseTime(input, now, time.Time{})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+}
+
+func TestParseTime_Flux(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	parser := NewParser(WithDialect(FluxDialect))
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "now()", expected: now},
+		{input: "-1h", expected: now.Add(-time.Hour)},
+		{input: "-1h30m", expected: now.Add(-90 * time.Minute)},
+		{input: "1h", expected: now.Add(time.Hour)},
+		{input: "-1d", expected: now.Add(-24 * time.Hour)},
+		{input: "-1mo2w", expected: time.Date(2025, 10, 27, 15, 30, 45, 0, time.UTC)},
+		{input: "-1y", expected: time.Date(2024, 12, 10, 15, 30, 45, 0, time.UTC)},
+		{input: "-5us", expected: now.Add(-5 * time.Microsecond)},
+		{input: "2025-12-10T15:00:00Z", expected: time.Date(2025, 12, 10, 15, 0, 0, 0, time.UTC)},
+		{input: "2025-12-10T15:00:00.5+01:00", expected: time.Date(2025, 12, 10, 14, 0, 0, 500000000, time.UTC)},
+		{input: "2025-12-10", expected: midnight(2025, 12, 10)},
+		{input: "1416434697", expected: time.Unix(1416434697, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := parser.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+
+	t.Run("months clamp to the end of the month", func(t *testing.T) {
+		result, err := parser.ParseTime("-1mo", time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC), time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC), result)
+	})
+
+	for _, input := range []string{"-1x", "now() - 1h", "1.5h", "-", "-1u"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parser.ParseTime(input, now, time.Time{})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+}
+
+func TestParseRange_InfluxConditions(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	influxQL := NewParser(WithDialect(InfluxQLDialect), WithClock(fixedTime))
+	flux := NewParser(WithDialect(FluxDialect), WithClock(fixedTime))
+
+	tests := []struct {
+		parser *Parser
+		input  string
+		start  time.Time
+		end    time.Time
+	}{
+		{parser: influxQL, input: "time >= now() - 1h AND time < now()", start: now.Add(-time.Hour), end: now},
+		{
+			parser: influxQL,
+			input:  "time > '2025-12-01' and time <= '2025-12-10'",
+			start:  midnight(2025, 12, 1),
+			end:    midnight(2025, 12, 10),
+		},
+		{parser: influxQL, input: "time >= now() - 7d", start: now.AddDate(0, 0, -7)},
+		{parser: influxQL, input: "now() - 1h", start: now.Add(-time.Hour), end: now.Add(-time.Hour)},
+		{parser: flux, input: "range(start: -1h, stop: now())", start: now.Add(-time.Hour), end: now},
+		{parser: flux, input: "|> range(start: -7d)", start: now.AddDate(0, 0, -7), end: now},
+		{
+			parser: flux,
+			input:  "start: 2025-12-01T00:00:00Z, stop: 2025-12-10T00:00:00Z",
+			start:  midnight(2025, 12, 1),
+			end:    midnight(2025, 12, 10),
+		},
+		{parser: flux, input: "-1h", start: now.Add(-time.Hour), end: now.Add(-time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := tt.parser.ParseRange(tt.input)
+			require.NoError(t, err)
+			assert.True(t, tt.start.Equal(r.Start), "expected start %v, got %v", tt.start, r.Start)
+			assert.True(t, tt.end.Equal(r.End), "expected end %v, got %v", tt.end, r.End)
+		})
+	}
+
+	invalid := []struct {
+		parser *Parser
+		input  string
+	}{
+		{parser: influxQL, input: "time = now()"},
+		{parser: influxQL, input: "host = 'a' AND time > now()"},
+		{parser: influxQL, input: "time >= now() AND time < now() - 1h"},
+		{parser: flux, input: "range(stop: now())"},
+		{parser: flux, input: "range(start: -1h, every: 1m)"},
+		{parser: flux, input: "range(start: -1h"},
+	}
+
+	for _, tt := range invalid {
+		t.Run("invalid "+tt.input, func(t *testing.T) {
+			_, err := tt.parser.ParseRange(tt.input)
+			require.Error(t, err)
+		})
+	}
+}
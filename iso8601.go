@@ -0,0 +1,219 @@
+package friendlytime
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// isoDesignator maps an ISO 8601 duration designator to a duration unit.
+type isoDesignator struct {
+	designator byte
+	unit       string
+}
+
+// getISODateDesignators returns the designators allowed before "T", in order.
+//
+// Hours and seconds are unambiguous, so "P2H" is accepted as a lenient form
+// of "PT2H". Minutes still need the "T", since "P2M" is two months.
+func getISODateDesignators() []isoDesignator {
+	return []isoDesignator{
+		{designator: 'y', unit: "y"},
+		{designator: 'm', unit: "mo"},
+		{designator: 'w', unit: "w"},
+		{designator: 'd', unit: "d"},
+		{designator: 'h', unit: "h"},
+		{designator: 's', unit: "s"},
+	}
+}
+
+// getISOTimeDesignators returns the designators allowed after "T", in order.
+func getISOTimeDesignators() []isoDesignator {
+	return []isoDesignator{
+		{designator: 'h', unit: "h"},
+		{designator: 'm', unit: "m"},
+		{designator: 's', unit: "s"},
+	}
+}
+
+// parseISODuration parses an ISO 8601 duration such as "P1Y2M10DT2H30M",
+// "PT15M" or "P2W". Input is expected in lower case.
+//
+// Years, months, weeks and days are nominal: they are applied on the
+// calendar regardless of the parser's options. Only the last component may
+// have a fraction, written with "." or ",", and fractional years must amount
+// to whole months.
+func parseISODuration(durationStr string) (span, error) {
+	rest, ok := strings.CutPrefix(durationStr, "p")
+	if !ok || rest == "" {
+		return span{}, fmt.Errorf("%w: %q is not an ISO 8601 duration", ErrInvalidDuration, durationStr)
+	}
+
+	datePart, timePart, hasTime := strings.Cut(rest, "t")
+	if hasTime && timePart == "" {
+		return span{}, fmt.Errorf("%w: %q has no time components after T", ErrInvalidDuration, durationStr)
+	}
+
+	terms, err := parseISOComponents(datePart, getISODateDesignators(), durationStr)
+	if err != nil {
+		return span{}, err
+	}
+
+	timeTerms, err := parseISOComponents(timePart, getISOTimeDesignators(), durationStr)
+	if err != nil {
+		return span{}, err
+	}
+
+	terms = append(terms, timeTerms...)
+	if len(terms) == 0 {
+		return span{}, fmt.Errorf("%w: %q has no components", ErrInvalidDuration, durationStr)
+	}
+
+	for _, term := range terms[:len(terms)-1] {
+		if !term.amount.IsInt() {
+			return span{}, fmt.Errorf(
+				"%w: only the last component of %q may be fractional",
+				ErrInvalidDuration,
+				durationStr,
+			)
+		}
+	}
+
+	return sumTerms(terms, durationStr, true)
+}
+
+// parseISOComponents parses a run of <number><designator> components, whose
+// designators must appear in the given order.
+func parseISOComponents(s string, designators []isoDesignator, durationStr string) ([]durationTerm, error) {
+	var terms []durationTerm
+
+	next := 0
+
+	for s != "" {
+		numberStr, rest := cutNumber(strings.Replace(s, ",", ".", 1))
+		if numberStr == "" || rest == "" {
+			return nil, fmt.Errorf("%w: malformed ISO 8601 duration %q", ErrInvalidDuration, durationStr)
+		}
+
+		index := next
+		for index < len(designators) && designators[index].designator != rest[0] {
+			index++
+		}
+
+		if index == len(designators) {
+			return nil, fmt.Errorf(
+				"%w: unexpected designator %q in %q",
+				ErrInvalidDuration,
+				strings.ToUpper(rest[:1]),
+				durationStr,
+			)
+		}
+
+		amount, ok := new(big.Rat).SetString(numberStr)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid amount %q", ErrInvalidDuration, numberStr)
+		}
+
+		unit, _ := lookupDurationUnit(designators[index].unit)
+		terms = append(terms, durationTerm{amount: amount, unit: unit})
+		s = rest[1:]
+		next = index + 1
+	}
+
+	return terms, nil
+}
+
+// looksLikeISODuration reports whether s starts like an ISO 8601 duration:
+// "p" followed by a digit or "t". Input is expected in lower case.
+func looksLikeISODuration(s string) bool {
+	return len(s) > 1 && s[0] == 'p' && (s[1] == 't' || (s[1] >= '0' && s[1] <= '9'))
+}
+
+// tryParseISOIntervalDuration parses s as the duration of an ISO 8601
+// interval. It reports false if s doesn't look like an ISO 8601 duration.
+func tryParseISOIntervalDuration(s string) (span, bool, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if !looksLikeISODuration(s) {
+		return span{}, false, nil
+	}
+
+	duration, err := parseISODuration(s)
+	if err != nil {
+		return span{}, true, fmt.Errorf("%w: %w", ErrInvalidTimeFormat, err)
+	}
+
+	return duration, true, nil
+}
+
+// tryParseISOInterval handles the ISO 8601 interval forms with a duration on
+// one side: "<start>/<duration>" and "<duration>/<end>". The other side may
+// be any expression ParseTime accepts; an empty end means now.
+//
+// A duration on both sides isn't an ISO 8601 interval and is left to the
+// usual range parsing, where each duration counts back from now.
+func (p *Parser) tryParseISOInterval(startStr, endStr string, now time.Time) (Range, bool, error) {
+	startDuration, startIsDuration, err := tryParseISOIntervalDuration(startStr)
+	if err != nil {
+		return Range{}, true, fmt.Errorf("%w: %w", ErrInvalidStartTime, err)
+	}
+
+	endDuration, endIsDuration, err := tryParseISOIntervalDuration(endStr)
+	if err != nil {
+		return Range{}, true, fmt.Errorf("%w: %w", ErrInvalidEndTime, err)
+	}
+
+	switch {
+	case startIsDuration && !endIsDuration:
+		end, err := p.parseBound(endStr, now, now, true)
+		if err != nil {
+			return Range{}, true, fmt.Errorf("%w: %w", ErrInvalidEndTime, err)
+		}
+
+		return Range{Start: startDuration.addTo(end, -1), End: end}, true, nil
+	case endIsDuration && !startIsDuration:
+		if strings.TrimSpace(startStr) == "" {
+			return Range{}, true, fmt.Errorf("%w: an interval with a duration needs a start", ErrInvalidTimeRange)
+		}
+
+		start, err := p.parseBound(startStr, now, time.Time{}, false)
+		if err != nil {
+			return Range{}, true, fmt.Errorf("%w: %w", ErrInvalidStartTime, err)
+		}
+
+		return Range{Start: start, End: endDuration.addTo(start, 1)}, true, nil
+	default:
+		return Range{}, false, nil
+	}
+}
+
+// getISOTimestampFormats returns the ISO 8601 date and time layouts accepted
+// by dialects that take ISO 8601 timestamps, most specific first.
+func getISOTimestampFormats() []string {
+	return []string{
+		time.RFC3339Nano,
+		"2006-01-02T15:04Z07:00",
+		"2006-01-02T15:04:05.999999999",
+		"2006-01-02T15:04",
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02 15:04",
+		"2006-01-02",
+		"2006-01",
+		"20060102T150405Z07:00",
+		"20060102T150405",
+		"20060102",
+	}
+}
+
+// parseISOTimestamp parses an ISO 8601 date or date and time. Times without
+// a zone are read in loc.
+func parseISOTimestamp(s string, loc *time.Location) (time.Time, bool) {
+	for _, format := range getISOTimestampFormats() {
+		if t, err := time.ParseInLocation(format, s, loc); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,169 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseISODuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected span
+	}{
+		{input: "PT15M", expected: span{exact: 15 * time.Minute}},
+		{input: "P1DT2H", expected: span{days: 1, exact: 2 * time.Hour}},
+		{input: "P1Y2M10D", expected: span{months: 14, days: 10}},
+		{input: "P2W", expected: span{days: 14}},
+		{input: "P1Y2M3W4DT5H6M7S", expected: span{months: 14, days: 25, exact: 5*time.Hour + 6*time.Minute + 7*time.Second}},
+		{input: "PT0.5S", expected: span{exact: 500 * time.Millisecond}},
+		{input: "PT1,5H", expected: span{exact: 90 * time.Minute}},
+		{input: "P1.5D", expected: span{days: 1, exact: 12 * time.Hour}},
+		{input: "P1.5Y", expected: span{months: 18}},
+		{input: "P0D", expected: span{}},
+		{input: "pt36h", expected: span{exact: 36 * time.Hour}},
+		{input: "P2H", expected: span{exact: 2 * time.Hour}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := defaultParser.parseSpan(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	for _, input := range []string{"P", "PT", "P1H30M", "PT1D", "P1D2Y", "P1.5DT2H", "P1", "PxD", "P1DT", "P1.5M"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := defaultParser.parseSpan(input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidDuration))
+		})
+	}
+}
+
+func TestParseTime_ISODurations(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	startTime := now.Add(-2 * time.Hour)
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "PT15M", expected: now.Add(-15 * time.Minute)},
+		{input: "P1DT2H", expected: now.AddDate(0, 0, -1).Add(-2 * time.Hour)},
+		{input: "-P1D", expected: now.AddDate(0, 0, -1)},
+		{input: "+PT30M", expected: startTime.Add(30 * time.Minute)},
+		{input: "P1M", expected: now.AddDate(0, -1, 0)},
+		{input: "P2W ago", expected: now.AddDate(0, 0, -14)},
+		{input: "in PT2H", expected: now.Add(2 * time.Hour)},
+		{input: "now-P1Y", expected: now.AddDate(-1, 0, 0)},
+		{input: "PT1H before yesterday", expected: time.Date(2025, 12, 8, 23, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseTime(tt.input, now, startTime)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+
+	t.Run("range", func(t *testing.T) {
+		r, err := NewParser(WithClock(fixedTime)).ParseRange("P1D/PT1H")
+		require.NoError(t, err)
+		assert.Equal(t, now.AddDate(0, 0, -1), r.Start)
+		assert.Equal(t, now.Add(-time.Hour), r.End)
+	})
+}
+
+func TestParseRange_ISOIntervals(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	parser := NewParser(WithClock(fixedTime))
+
+	tests := []struct {
+		input string
+		start time.Time
+		end   time.Time
+	}{
+		{
+			input: "2025-12-10T00:00Z/2025-12-11T00:00Z",
+			start: midnight(2025, 12, 10),
+			end:   midnight(2025, 12, 11),
+		},
+		{input: "2025-12-10T00:00Z/P1D", start: midnight(2025, 12, 10), end: midnight(2025, 12, 11)},
+		{
+			input: "P2H/2025-12-10T15:00Z",
+			start: time.Date(2025, 12, 10, 13, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, 12, 10, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			input: "PT2H/2025-12-10T17:00:00+02:00",
+			start: time.Date(2025, 12, 10, 13, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, 12, 10, 15, 0, 0, 0, time.UTC),
+		},
+		{input: "2025-01-31/P1M", start: midnight(2025, 1, 31), end: midnight(2025, 3, 3)},
+		{input: "P1D", start: now.AddDate(0, 0, -1), end: now},
+		{input: "PT15M", start: now.Add(-15 * time.Minute), end: now},
+		{input: "P1D/", start: now.AddDate(0, 0, -1), end: now},
+		{input: "P1W/yesterday", start: midnight(2025, 12, 2), end: midnight(2025, 12, 9)},
+		{input: "Q3 2025/P1M", start: midnight(2025, 7, 1), end: midnight(2025, 8, 1)},
+		{input: "P1M/Q3 2025", start: midnight(2025, 9, 1), end: midnight(2025, 10, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := parser.ParseRange(tt.input)
+			require.NoError(t, err)
+			assert.True(t, tt.start.Equal(r.Start), "expected start %v, got %v", tt.start, r.Start)
+			assert.True(t, tt.end.Equal(r.End), "expected end %v, got %v", tt.end, r.End)
+			assert.Equal(t, tt.end.Sub(tt.start), r.Duration())
+		})
+	}
+
+	invalid := []struct {
+		input   string
+		errType error
+	}{
+		{input: "P1X/2025-12-10", errType: ErrInvalidStartTime},
+		{input: "2025-12-10/P1DT", errType: ErrInvalidEndTime},
+		{input: "/P1D", errType: ErrInvalidTimeRange},
+		{input: "nonsense/P1D", errType: ErrInvalidStartTime},
+		{input: "P1Q", errType: ErrInvalidTimeFormat},
+	}
+
+	for _, tt := range invalid {
+		t.Run("invalid "+tt.input, func(t *testing.T) {
+			_, err := parser.ParseRange(tt.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.errType), "expected %v, got %v", tt.errType, err)
+		})
+	}
+}
+
+func TestParseTime_ISOTimestamps(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "2025-12-10T15:00:00Z", expected: time.Date(2025, 12, 10, 15, 0, 0, 0, time.UTC)},
+		{input: "2025-12-10T15:00:00.5Z", expected: time.Date(2025, 12, 10, 15, 0, 0, 500000000, time.UTC)},
+		{input: "2025-12-10T17:00:00+02:00", expected: time.Date(2025, 12, 10, 15, 0, 0, 0, time.UTC)},
+		{input: "2025-12-10T15:00Z", expected: time.Date(2025, 12, 10, 15, 0, 0, 0, time.UTC)},
+		{input: "2025-12-10T15:00", expected: time.Date(2025, 12, 10, 15, 0, 0, 0, time.UTC)},
+		{input: "2025-12-10T15:00:30", expected: time.Date(2025, 12, 10, 15, 0, 30, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseTime(tt.input, fixedTime(), time.Time{})
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+}
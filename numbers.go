@@ -0,0 +1,104 @@
+package friendlytime
+
+import (
+	"math/big"
+	"strings"
+)
+
+const (
+	// Amounts used for vague quantities in duration expressions.
+	defaultFewAmount = 3
+	severalAmount    = 5
+	coupleAmount     = 2
+	tensStep         = 10
+)
+
+// getSmallNumberWords returns the names of the numbers zero to nineteen, indexed by value.
+func getSmallNumberWords() []string {
+	return []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen",
+	}
+}
+
+// getTensNumberWords returns the names of the multiples of ten, indexed by value / 10.
+func getTensNumberWords() []string {
+	return []string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+	}
+}
+
+// parseAmountWords parses a spelled-out amount from the start of s and
+// returns the remainder. It accepts cardinal numbers up to ninety-nine
+// ("two", "twenty-one", "forty two"), the articles "a" and "an", "half"
+// ("half an hour"), "a couple (of)", "a few" and "several".
+func (p *Parser) parseAmountWords(s string) (*big.Rat, string, bool) {
+	word, rest := nextWord(s)
+
+	if word == "a" || word == "an" {
+		next, tail := nextWord(rest)
+		if next != "couple" && next != "few" {
+			return big.NewRat(1, 1), rest, true
+		}
+
+		word, rest = next, tail
+	}
+
+	switch word {
+	case "half":
+		if next, tail := nextWord(rest); next == "a" || next == "an" {
+			rest = tail
+		}
+
+		return big.NewRat(1, 2), rest, true
+	case "couple":
+		if next, tail := nextWord(rest); next == "of" {
+			rest = tail
+		}
+
+		return big.NewRat(coupleAmount, 1), rest, true
+	case "few":
+		return big.NewRat(int64(p.fewAmount), 1), rest, true
+	case "several":
+		return big.NewRat(severalAmount, 1), rest, true
+	}
+
+	number, ok := lookupNumberWord(word)
+	if !ok {
+		return nil, s, false
+	}
+
+	// Combine tens with a following digit word: "twenty-one", "forty two".
+	if number >= 2*tensStep && number%tensStep == 0 {
+		next, tail := nextWord(strings.TrimPrefix(rest, "-"))
+		if digit, ok := lookupNumberWord(next); ok && digit > 0 && digit < tensStep {
+			number += digit
+			rest = tail
+		}
+	}
+
+	return big.NewRat(int64(number), 1), rest, true
+}
+
+// lookupNumberWord returns the value of a single number word.
+func lookupNumberWord(word string) (int, bool) {
+	for value, name := range getSmallNumberWords() {
+		if name == word {
+			return value, true
+		}
+	}
+
+	for index, name := range getTensNumberWords() {
+		if name != "" && name == word {
+			return index * tensStep, true
+		}
+	}
+
+	return 0, false
+}
+
+// nextWord skips leading blanks and splits s into its first word and the remainder.
+func nextWord(s string) (string, string) {
+	return cutWord(strings.TrimLeft(s, " \t"))
+}
@@ -0,0 +1,98 @@
+package friendlytime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmountWords(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected float64
+		rest     string
+	}{
+		{input: "zero hours", expected: 0, rest: " hours"},
+		{input: "two hours", expected: 2, rest: " hours"},
+		{input: "nineteen days", expected: 19, rest: " days"},
+		{input: "twenty minutes", expected: 20, rest: " minutes"},
+		{input: "twenty-one minutes", expected: 21, rest: " minutes"},
+		{input: "forty two seconds", expected: 42, rest: " seconds"},
+		{input: "ninety-nine years", expected: 99, rest: " years"},
+		{input: "a week", expected: 1, rest: " week"},
+		{input: "an hour", expected: 1, rest: " hour"},
+		{input: "one day", expected: 1, rest: " day"},
+		{input: "half an hour", expected: 0.5, rest: " hour"},
+		{input: "half a day", expected: 0.5, rest: " day"},
+		{input: "half hour", expected: 0.5, rest: " hour"},
+		{input: "a couple of days", expected: 2, rest: " days"},
+		{input: "a couple days", expected: 2, rest: " days"},
+		{input: "couple of weeks", expected: 2, rest: " weeks"},
+		{input: "a few minutes", expected: 3, rest: " minutes"},
+		{input: "few minutes", expected: 3, rest: " minutes"},
+		{input: "several hours", expected: 5, rest: " hours"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			amount, rest, ok := defaultParser.parseAmountWords(tt.input)
+			require.True(t, ok)
+
+			value, _ := amount.Float64()
+			assert.InDelta(t, tt.expected, value, 0)
+			assert.Equal(t, tt.rest, rest)
+		})
+	}
+
+	for _, input := range []string{"5 hours", "hours", "lots of hours", ""} {
+		t.Run("not a number word: "+input, func(t *testing.T) {
+			_, _, ok := defaultParser.parseAmountWords(input)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestParseTime_SpelledOutAmounts(t *testing.T) {
+	now := fixedTime()
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "two hours ago", expected: now.Add(-2 * time.Hour)},
+		{input: "an hour ago", expected: now.Add(-time.Hour)},
+		{input: "a week ago", expected: now.Add(-7 * 24 * time.Hour)},
+		{input: "a couple of days ago", expected: now.Add(-48 * time.Hour)},
+		{input: "half an hour ago", expected: now.Add(-30 * time.Minute)},
+		{input: "a few minutes ago", expected: now.Add(-3 * time.Minute)},
+		{input: "several seconds ago", expected: now.Add(-5 * time.Second)},
+		{input: "Twenty-Five Minutes Ago", expected: now.Add(-25 * time.Minute)},
+		{input: "one day and two hours ago", expected: now.Add(-26 * time.Hour)},
+		{input: "in a couple of hours", expected: now.Add(2 * time.Hour)},
+		{input: "+an hour", expected: now.Add(time.Hour)},
+		{input: "three days", expected: now.Add(-72 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("configured few amount", func(t *testing.T) {
+		parser := NewParser(WithFewAmount(4))
+
+		result, err := parser.ParseTime("a few days ago", now, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(-96*time.Hour), result)
+	})
+
+	t.Run("missing unit", func(t *testing.T) {
+		_, err := ParseTime("two ago", now, time.Time{})
+		require.Error(t, err)
+	})
+}
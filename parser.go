@@ -0,0 +1,210 @@
+package friendlytime
+
+import "time"
+
+// Parser parses time expressions using a fixed set of options.
+// A Parser is safe for concurrent use.
+type Parser struct {
+	calendarUnits        bool
+	fewAmount            int
+	location             *time.Location
+	clock                func() time.Time
+	fiscalYearStartMonth time.Month
+	weekNumbering        WeekNumbering
+	weekStartDay         time.Weekday
+	weekStartSet         bool
+	lastWeekdayPrevWeek  bool
+	weekend              [daysPerWeek]bool
+	prefer               Prefer
+	dialect              Dialect
+	roundUp              bool
+	strictTimestamps     bool
+	inDateMath           bool
+}
+
+// Option configures a Parser.
+type Option func(*Parser)
+
+// defaultParser backs the package-level parsing functions.
+var defaultParser = NewParser()
+
+// NewParser returns a Parser configured with the given options.
+func NewParser(opts ...Option) *Parser {
+	p := &Parser{
+		fewAmount:            defaultFewAmount,
+		clock:                time.Now,
+		fiscalYearStartMonth: time.January,
+	}
+
+	p.weekend[time.Saturday] = true
+	p.weekend[time.Sunday] = true
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// WithCalendarUnits controls how day- and month-based units are applied.
+//
+// By default they have fixed lengths: a day is 24 hours, a week 7 days, a
+// month 30 days, a quarter 90 days and a year 365 days. With calendar units
+// enabled they follow the calendar instead: "1 day ago" keeps the wall clock
+// time across a DST change, and months overflow the way time.Time.AddDate
+// normalizes them (one month before March 31 is March 3 in a non-leap year).
+//
+// Fractional days keep their fraction as hours, fractional years must amount
+// to whole months ("1.5 years" is 18 months), and fractional months are
+// rejected with ErrFractionalCalendarUnit.
+func WithCalendarUnits(enabled bool) Option {
+	return func(p *Parser) {
+		p.calendarUnits = enabled
+	}
+}
+
+// WithFewAmount sets the amount "a few" stands for in duration expressions
+// such as "a few minutes ago". The default is 3; non-positive values are ignored.
+func WithFewAmount(n int) Option {
+	return func(p *Parser) {
+		if n > 0 {
+			p.fewAmount = n
+		}
+	}
+}
+
+// WithLocation sets the time zone expressions are resolved in. The reference
+// time is converted to it before parsing, so "yesterday" or "the 15th" refer
+// to calendar days there, and dates without a zone ("2025-12-10") are read in
+// it. By default the reference time's own location is used and such dates
+// are read as UTC.
+func WithLocation(loc *time.Location) Option {
+	return func(p *Parser) {
+		p.location = loc
+	}
+}
+
+// WithClock sets the function used to read the current time when parsing
+// ranges. The default is time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(p *Parser) {
+		if now != nil {
+			p.clock = now
+		}
+	}
+}
+
+// WithFiscalYearStart sets the month fiscal years start in, used by "FY2026",
+// "Q1 FY2026" and "this fiscal year". Fiscal years are named after the
+// calendar year they end in. The default is January.
+func WithFiscalYearStart(month time.Month) Option {
+	return func(p *Parser) {
+		if month >= time.January && month <= time.December {
+			p.fiscalYearStartMonth = month
+		}
+	}
+}
+
+// WithWeekNumbering sets how "week 42", "2025-W42" and "this calendar week"
+// are numbered and aligned. The default is ISOWeekNumbering.
+func WithWeekNumbering(numbering WeekNumbering) Option {
+	return func(p *Parser) {
+		p.weekNumbering = numbering
+	}
+}
+
+// WithWeekStart sets the first day of the week. It aligns calendar weeks
+// ("this calendar week", "week 42") and decides which week "friday last
+// week" falls in. By default weeks start on Monday, or on Sunday with
+// USWeekNumbering. Invalid weekdays are ignored.
+//
+// The week start doesn't change "last monday", which is the most recent
+// Monday before today, unless WithLastWeekdayInPreviousWeek is enabled.
+func WithWeekStart(day time.Weekday) Option {
+	return func(p *Parser) {
+		if day >= time.Sunday && day <= time.Saturday {
+			p.weekStartDay = day
+			p.weekStartSet = true
+		}
+	}
+}
+
+// WithLastWeekdayInPreviousWeek makes "last <weekday>" mean that day of the
+// previous week, with weeks starting as set by WithWeekStart: on Wednesday,
+// December 10, 2025, with Monday week starts, "last monday" is December 1.
+// By default it is the most recent such day before today (December 8).
+func WithLastWeekdayInPreviousWeek(enabled bool) Option {
+	return func(p *Parser) {
+		p.lastWeekdayPrevWeek = enabled
+	}
+}
+
+// WithWeekend sets the days that make up the weekend, used by "this weekend",
+// "last weekend" and "last business day". The default is Saturday and Sunday.
+// Invalid weekdays are ignored.
+func WithWeekend(days ...time.Weekday) Option {
+	return func(p *Parser) {
+		p.weekend = [daysPerWeek]bool{}
+
+		for _, day := range days {
+			if day >= time.Sunday && day <= time.Saturday {
+				p.weekend[day] = true
+			}
+		}
+	}
+}
+
+// WithPrefer sets how a bare time of day, weekday, month or day of the month
+// is resolved. The default is PreferLiteral.
+func WithPrefer(prefer Prefer) Option {
+	return func(p *Parser) {
+		p.prefer = prefer
+	}
+}
+
+// WithDialect selects the grammar the parser accepts. The default is
+// DefaultDialect; see Dialect for the others.
+func WithDialect(dialect Dialect) Option {
+	return func(p *Parser) {
+		p.dialect = dialect
+	}
+}
+
+// WithRoundUp makes ParseTime and ParseRange round up in dialects with date
+// math rounding, the way Elasticsearch evaluates lte and gt bounds: "now/d"
+// is the last millisecond of today. ParseBounds always rounds the start down
+// and the end up. The default dialect is not affected.
+func WithRoundUp(enabled bool) Option {
+	return func(p *Parser) {
+		p.roundUp = enabled
+	}
+}
+
+// WithStrictTimestamps makes Unix timestamps require an explicit unit, as in
+// "@1416434697s" or "@1416434697123ms". By default a bare number such as
+// "1416434697" is also a timestamp, and its unit is guessed from its
+// magnitude: seconds, milliseconds, microseconds or nanoseconds. In strict
+// mode bare numbers are not timestamps.
+func WithStrictTimestamps(enabled bool) Option {
+	return func(p *Parser) {
+		p.strictTimestamps = enabled
+	}
+}
+
+// localize converts t to the configured location, if any.
+func (p *Parser) localize(t time.Time) time.Time {
+	if p.location == nil {
+		return t
+	}
+
+	return t.In(p.location)
+}
+
+// dateLocation returns the location used for dates without a time zone.
+func (p *Parser) dateLocation() *time.Location {
+	if p.location == nil {
+		return time.UTC
+	}
+
+	return p.location
+}
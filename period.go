@@ -0,0 +1,312 @@
+package friendlytime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	monthsPerQuarter = 3
+	monthsPerHalf    = 6
+	monthsPerYear    = 12
+	quartersPerYear  = 4
+	halvesPerYear    = 2
+
+	// Two-digit fiscal years ("FY26") are read as years of this century.
+	fiscalYearCentury = 2000
+	shortYearDigits   = 2
+)
+
+// periodPart is one token of a period expression such as "Q3" or "FY2026".
+type periodPart struct {
+	kind  byte // 'q' quarter, 'h' half, 'w' week, 'f' fiscal year, 'y' calendar year
+	value int
+	text  string
+}
+
+// tryParsePeriod handles expressions naming a whole quarter, half-year,
+// calendar week or fiscal year and returns the period as [start, end).
+//
+// Accepted forms are "Q1".."Q4" and "H1"/"H2" with an optional calendar year
+// ("Q3 2025", "2025-Q3") or fiscal year ("Q1 FY2026"), "FY2026"/"FY26",
+// numbered weeks ("week 42", "W42 2025", "2025-W42"), months ("march",
+// "march 2025"), and "this", "last" or
+// "next" followed by "quarter", "half", "calendar week" or "fiscal year".
+// Quarters and halves without a year are in the current calendar year, and
+// weeks without a year in the current week-numbering year.
+func (p *Parser) tryParsePeriod(timeStr string, now time.Time) (time.Time, time.Time, bool, error) {
+	lowerTimeStr := strings.Join(strings.Fields(strings.ToLower(timeStr)), " ")
+
+	if start, end, ok := p.tryParseRelativePeriod(lowerTimeStr, now); ok {
+		return start, end, true, nil
+	}
+
+	if start, end, ok := p.tryParseMonthPeriod(lowerTimeStr, now); ok {
+		return start, end, true, nil
+	}
+
+	fields := mergeWeekFields(strings.Fields(strings.ReplaceAll(lowerTimeStr, "-", " ")))
+	if len(fields) == 0 || len(fields) > 2 {
+		return time.Time{}, time.Time{}, false, nil
+	}
+
+	parts := make([]periodPart, 0, len(fields))
+
+	for _, field := range fields {
+		part, ok := parsePeriodPart(field)
+		if !ok {
+			return time.Time{}, time.Time{}, false, nil
+		}
+
+		parts = append(parts, part)
+	}
+
+	return p.resolvePeriod(parts, now)
+}
+
+// tryParseRelativePeriod handles "this/last/next quarter", "this/last/next half",
+// "this/last/next calendar week", "this/last/next weekend" and
+// "this/last/next fiscal year".
+func (p *Parser) tryParseRelativePeriod(timeStr string, now time.Time) (time.Time, time.Time, bool) {
+	relation, unit, found := strings.Cut(timeStr, " ")
+	if !found {
+		return time.Time{}, time.Time{}, false
+	}
+
+	offset := 0
+
+	switch relation {
+	case "this", "current":
+	case "last", "previous":
+		offset = -1
+	case "next":
+		offset = 1
+	default:
+		return time.Time{}, time.Time{}, false
+	}
+
+	switch unit {
+	case "calendar week":
+		start := p.startOfWeek(now).AddDate(0, 0, offset*daysPerWeek)
+
+		return start, start.AddDate(0, 0, daysPerWeek), true
+	case "weekend":
+		return p.weekendAround(now, offset)
+	}
+
+	var start time.Time
+
+	var months int
+
+	switch unit {
+	case "quarter":
+		months = monthsPerQuarter
+		start = periodStart(now, months)
+	case "half", "half year", "half-year":
+		months = monthsPerHalf
+		start = periodStart(now, months)
+	case "fiscal year":
+		months = monthsPerYear
+		start = p.fiscalYearStart(p.fiscalYearOf(now), now.Location())
+	default:
+		return time.Time{}, time.Time{}, false
+	}
+
+	start = start.AddDate(0, offset*months, 0)
+
+	return start, start.AddDate(0, months, 0), true
+}
+
+// tryParseMonthPeriod handles a month name with an optional year, such as
+// "march" or "march 2025". A month without a year is in the current year
+// unless the parser prefers another.
+func (p *Parser) tryParseMonthPeriod(timeStr string, now time.Time) (time.Time, time.Time, bool) {
+	fields := strings.Fields(timeStr)
+	if len(fields) == 0 {
+		return time.Time{}, time.Time{}, false
+	}
+
+	if _, ok := parseMonth(fields[0]); !ok {
+		return time.Time{}, time.Time{}, false
+	}
+
+	year, month, err := parseNamedMonth(timeStr, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	start := time.Date(year, month, 1, 0, 0, 0, 0, now.Location())
+
+	if len(fields) == 1 {
+		thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+		start = p.preferred(func(n int) time.Time {
+			return start.AddDate(n, 0, 0)
+		}, thisMonth)
+	}
+
+	return start, start.AddDate(0, 1, 0), true
+}
+
+// parsePeriodPart parses one token of a period expression.
+func parsePeriodPart(field string) (periodPart, bool) {
+	var kind byte
+
+	var digits string
+
+	switch {
+	case strings.HasPrefix(field, "fy"):
+		kind, digits = 'f', field[2:]
+	case strings.HasPrefix(field, "q"):
+		kind, digits = 'q', field[1:]
+	case strings.HasPrefix(field, "h"):
+		kind, digits = 'h', field[1:]
+	case strings.HasPrefix(field, "w"):
+		kind, digits = 'w', field[1:]
+	case len(field) == yearDigits:
+		kind, digits = 'y', field
+	default:
+		return periodPart{}, false
+	}
+
+	if digits == "" || strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }) != -1 {
+		return periodPart{}, false
+	}
+
+	value, err := strconv.Atoi(digits)
+	if err != nil {
+		return periodPart{}, false
+	}
+
+	if kind == 'f' {
+		switch len(digits) {
+		case shortYearDigits:
+			value += fiscalYearCentury
+		case yearDigits:
+		default:
+			return periodPart{}, false
+		}
+	}
+
+	return periodPart{kind: kind, value: value, text: field}, true
+}
+
+// resolvePeriod turns the tokens of a period expression into [start, end).
+func (p *Parser) resolvePeriod(parts []periodPart, now time.Time) (time.Time, time.Time, bool, error) {
+	var period, year *periodPart
+
+	for i := range parts {
+		switch parts[i].kind {
+		case 'q', 'h', 'w':
+			if period != nil {
+				return time.Time{}, time.Time{}, false, nil
+			}
+
+			period = &parts[i]
+		default:
+			if year != nil {
+				return time.Time{}, time.Time{}, false, nil
+			}
+
+			year = &parts[i]
+		}
+	}
+
+	// A lone calendar year is not a period expression; it reads as a timestamp.
+	if period == nil && (year == nil || year.kind == 'y') {
+		return time.Time{}, time.Time{}, false, nil
+	}
+
+	if period != nil && period.kind == 'w' {
+		return p.resolveWeekPeriod(*period, year, now)
+	}
+
+	var yearStart time.Time
+
+	switch {
+	case year == nil:
+		yearStart = time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, now.Location())
+	case year.kind == 'f':
+		yearStart = p.fiscalYearStart(year.value, now.Location())
+	default:
+		yearStart = time.Date(year.value, time.January, 1, 0, 0, 0, 0, now.Location())
+	}
+
+	if period == nil {
+		return yearStart, yearStart.AddDate(0, monthsPerYear, 0), true, nil
+	}
+
+	months, count := monthsPerQuarter, quartersPerYear
+	if period.kind == 'h' {
+		months, count = monthsPerHalf, halvesPerYear
+	}
+
+	if period.value < 1 || period.value > count {
+		return time.Time{}, time.Time{}, true, fmt.Errorf(
+			"%w: %s is out of range, a year has %d",
+			ErrInvalidTimeFormat,
+			strings.ToUpper(period.text),
+			count,
+		)
+	}
+
+	start := yearStart.AddDate(0, (period.value-1)*months, 0)
+
+	return start, start.AddDate(0, months, 0), true, nil
+}
+
+// resolveWeekPeriod resolves a numbered week with an optional calendar year.
+func (p *Parser) resolveWeekPeriod(
+	week periodPart,
+	year *periodPart,
+	now time.Time,
+) (time.Time, time.Time, bool, error) {
+	weekYear := p.weekYear(now)
+
+	if year != nil {
+		// Weeks are numbered within calendar years, not fiscal years.
+		if year.kind != 'y' {
+			return time.Time{}, time.Time{}, false, nil
+		}
+
+		weekYear = year.value
+	}
+
+	start, end, err := p.resolveWeek(week, weekYear, now.Location())
+	if err != nil {
+		return time.Time{}, time.Time{}, true, err
+	}
+
+	return start, end, true, nil
+}
+
+// periodStart returns the start of the calendar period of the given length
+// in months (a quarter or a half-year) that contains t.
+func periodStart(t time.Time, months int) time.Time {
+	month := (int(t.Month())-1)/months*months + 1
+
+	return time.Date(t.Year(), time.Month(month), 1, 0, 0, 0, 0, t.Location())
+}
+
+// fiscalYearStart returns the first day of the fiscal year. Fiscal years are
+// named after the calendar year they end in, so with an October start FY2026
+// runs from October 1, 2025 to September 30, 2026.
+func (p *Parser) fiscalYearStart(fiscalYear int, loc *time.Location) time.Time {
+	year := fiscalYear
+	if p.fiscalYearStartMonth != time.January {
+		year--
+	}
+
+	return time.Date(year, p.fiscalYearStartMonth, 1, 0, 0, 0, 0, loc)
+}
+
+// fiscalYearOf returns the fiscal year containing t.
+func (p *Parser) fiscalYearOf(t time.Time) int {
+	if p.fiscalYearStartMonth != time.January && t.Month() >= p.fiscalYearStartMonth {
+		return t.Year() + 1
+	}
+
+	return t.Year()
+}
@@ -0,0 +1,100 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRange_Periods(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	parser := NewParser(WithClock(fixedTime))
+
+	tests := []struct {
+		input string
+		start time.Time
+		end   time.Time
+	}{
+		{input: "Q3", start: midnight(2025, 7, 1), end: midnight(2025, 10, 1)},
+		{input: "q1", start: midnight(2025, 1, 1), end: midnight(2025, 4, 1)},
+		{input: "Q3 2025", start: midnight(2025, 7, 1), end: midnight(2025, 10, 1)},
+		{input: "2024-Q4", start: midnight(2024, 10, 1), end: midnight(2025, 1, 1)},
+		{input: "H1", start: midnight(2025, 1, 1), end: midnight(2025, 7, 1)},
+		{input: "H2 2024", start: midnight(2024, 7, 1), end: midnight(2025, 1, 1)},
+		{input: "FY2026", start: midnight(2026, 1, 1), end: midnight(2027, 1, 1)},
+		{input: "this quarter", start: midnight(2025, 10, 1), end: midnight(2026, 1, 1)},
+		{input: "last quarter", start: midnight(2025, 7, 1), end: midnight(2025, 10, 1)},
+		{input: "next quarter", start: midnight(2026, 1, 1), end: midnight(2026, 4, 1)},
+		{input: "previous half", start: midnight(2025, 1, 1), end: midnight(2025, 7, 1)},
+		{input: "next half year", start: midnight(2026, 1, 1), end: midnight(2026, 7, 1)},
+		{input: "Q1/Q2", start: midnight(2025, 1, 1), end: midnight(2025, 7, 1)},
+		{input: "last quarter/now", start: midnight(2025, 7, 1), end: fixedTime()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := parser.ParseRange(tt.input)
+			require.NoError(t, err)
+			assert.True(t, tt.start.Equal(r.Start), "expected start %v, got %v", tt.start, r.Start)
+			assert.True(t, tt.end.Equal(r.End), "expected end %v, got %v", tt.end, r.End)
+		})
+	}
+
+	for _, input := range []string{"Q5", "Q0 2025", "H3"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parser.ParseRange(input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+}
+
+func TestParser_WithFiscalYearStart(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	parser := NewParser(WithClock(fixedTime), WithFiscalYearStart(time.October))
+
+	tests := []struct {
+		input string
+		start time.Time
+		end   time.Time
+	}{
+		{input: "FY2026", start: midnight(2025, 10, 1), end: midnight(2026, 10, 1)},
+		{input: "FY26", start: midnight(2025, 10, 1), end: midnight(2026, 10, 1)},
+		{input: "Q1 FY2026", start: midnight(2025, 10, 1), end: midnight(2026, 1, 1)},
+		{input: "FY2026 Q3", start: midnight(2026, 4, 1), end: midnight(2026, 7, 1)},
+		{input: "H2 FY2026", start: midnight(2026, 4, 1), end: midnight(2026, 10, 1)},
+		{input: "this fiscal year", start: midnight(2025, 10, 1), end: midnight(2026, 10, 1)},
+		{input: "last fiscal year", start: midnight(2024, 10, 1), end: midnight(2025, 10, 1)},
+		// Calendar quarters are unaffected by the fiscal year.
+		{input: "Q1 2026", start: midnight(2026, 1, 1), end: midnight(2026, 4, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := parser.ParseRange(tt.input)
+			require.NoError(t, err)
+			assert.True(t, tt.start.Equal(r.Start), "expected start %v, got %v", tt.start, r.Start)
+			assert.True(t, tt.end.Equal(r.End), "expected end %v, got %v", tt.end, r.End)
+		})
+	}
+}
+
+func TestParseTime_Periods(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+
+	result, err := ParseTime("last quarter", now, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, midnight(2025, 7, 1), result)
+
+	result, err = ParseTime("Q3 2025+1w", now, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, midnight(2025, 7, 8), result)
+
+	// A bare year is still not a period.
+	_, err = ParseTime("2025", now, time.Time{})
+	require.NoError(t, err)
+}
@@ -0,0 +1,482 @@
+package friendlytime
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	// PostgreSQL cascades fractional months into days of this length.
+	postgresDaysPerMonth = 30
+
+	// Fields of a SQL-standard year-month interval such as "1-2".
+	postgresYearMonthParts = 2
+
+	// Fields of a timestamp literal: a date, a time and a zone.
+	postgresTimestampFields = 3
+)
+
+// postgresUnit is a unit of PostgreSQL interval input. Exactly one of its
+// lengths is set: years and longer units count months and round fractions
+// to whole months, months and days cascade fractions down to the next
+// smaller unit, and shorter units are exact.
+type postgresUnit struct {
+	names  []string
+	years  int
+	months int
+	days   int
+	exact  time.Duration
+}
+
+// getPostgresUnits returns the units of PostgreSQL interval input.
+func getPostgresUnits() []postgresUnit {
+	return []postgresUnit{
+		{
+			names: []string{"microseconds", "microsecond", "microsecon", "useconds", "usecond", "usecs", "usec", "us"},
+			exact: time.Microsecond,
+		},
+		{
+			names: []string{"milliseconds", "millisecond", "millisecon", "mseconds", "msecond", "msecs", "msec", "ms"},
+			exact: time.Millisecond,
+		},
+		{names: []string{"seconds", "second", "secs", "sec", "s"}, exact: time.Second},
+		{names: []string{"minutes", "minute", "mins", "min", "m"}, exact: time.Minute},
+		{names: []string{"hours", "hour", "hrs", "hr", "h"}, exact: time.Hour},
+		{names: []string{"days", "day", "d"}, days: 1},
+		{names: []string{"weeks", "week", "w"}, days: daysPerWeek},
+		{names: []string{"months", "month", "mons", "mon"}, months: 1},
+		{names: []string{"years", "year", "yrs", "yr", "y"}, years: 1},
+		{names: []string{"decades", "decade", "decs", "dec"}, years: 10},
+		{names: []string{"centuries", "century", "cent", "c"}, years: 100},
+		{names: []string{"millennia", "millennium", "mils", "mil"}, years: 1000},
+	}
+}
+
+// lookupPostgresUnit finds the PostgreSQL interval unit with the given name.
+func lookupPostgresUnit(name string) (postgresUnit, bool) {
+	for _, unit := range getPostgresUnits() {
+		for _, unitName := range unit.names {
+			if unitName == name {
+				return unit, true
+			}
+		}
+	}
+
+	return postgresUnit{}, false
+}
+
+// parsePostgres parses a PostgreSQL timestamp or interval:
+//
+//   - special values "now", "today", "yesterday", "tomorrow" and "epoch"
+//   - timestamp literals such as "2025-12-10", "2025-12-10 15:30:45.5" and
+//     "2025-12-10 15:30:45+02", read in now's location without a zone
+//   - intervals, added to now as in now() + interval; see
+//     parsePostgresInterval
+//   - "infinity" and "-infinity", which have no time.Time value and return
+//     ErrInfinity and ErrNegativeInfinity; as range bounds they are open
+//     ends, see parsePostgresBound
+//
+// Surrounding single quotes, as in a SQL literal, are ignored.
+func (p *Parser) parsePostgres(timeStr string, now time.Time) (time.Time, error) {
+	timeStr = strings.Trim(strings.TrimSpace(timeStr), "'")
+	lower := strings.ToLower(timeStr)
+
+	switch lower {
+	case "":
+		return time.Time{}, nil
+	case "infinity", "+infinity":
+		return time.Time{}, ErrInfinity
+	case "-infinity":
+		return time.Time{}, ErrNegativeInfinity
+	case "now":
+		return now, nil
+	case "today":
+		return getMidnight(now), nil
+	case "yesterday":
+		return getMidnight(now).AddDate(0, 0, -1), nil
+	case "tomorrow":
+		return getMidnight(now).AddDate(0, 0, 1), nil
+	case "epoch":
+		return p.localize(time.Unix(0, 0)), nil
+	}
+
+	if t, ok := parsePostgresTimestamp(timeStr, now.Location()); ok {
+		return t, nil
+	}
+
+	interval, err := parsePostgresInterval(lower)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", ErrInvalidTimeFormat, err)
+	}
+
+	return addPostgresInterval(now, interval), nil
+}
+
+// parsePostgresBound parses one side of a range like parsePostgres.
+// "infinity" may only end a range and "-infinity" only start one, and both
+// are open ends, returned as the zero time.
+func (p *Parser) parsePostgresBound(timeStr string, now time.Time, isEnd bool) (time.Time, error) {
+	t, err := p.parsePostgres(timeStr, now)
+
+	switch {
+	case errors.Is(err, ErrInfinity) && !isEnd:
+		return time.Time{}, fmt.Errorf("%w: infinity cannot start a range", ErrInvalidTimeFormat)
+	case errors.Is(err, ErrNegativeInfinity) && isEnd:
+		return time.Time{}, fmt.Errorf("%w: -infinity cannot end a range", ErrInvalidTimeFormat)
+	case errors.Is(err, ErrInfinity), errors.Is(err, ErrNegativeInfinity):
+		return time.Time{}, nil
+	}
+
+	return t, err
+}
+
+// parsePostgresTimestamp parses a timestamp literal: an ISO 8601 date,
+// optionally followed by a time and a zone, separated by a space or "T".
+func parsePostgresTimestamp(s string, loc *time.Location) (time.Time, bool) {
+	if len(s) <= yearDigits || s[yearDigits] != '-' {
+		return time.Time{}, false
+	}
+
+	if i := len("2006-01-02"); len(s) > i && (s[i] == 'T' || s[i] == 't') {
+		s = s[:i] + " " + s[i+1:]
+	}
+
+	fields := strings.Fields(s)
+	if len(fields) > postgresTimestampFields {
+		return time.Time{}, false
+	}
+
+	dateStr := fields[0]
+
+	year, month, day, ok := parseNumericDate(dateStr)
+	if !ok || day > daysIn(year, month) {
+		return time.Time{}, false
+	}
+
+	hour, minute, sec, nsec := 0, 0, 0, 0
+
+	if len(fields) > 1 {
+		var zone *time.Location
+
+		hour, minute, sec, nsec, zone, ok = parseClock(strings.ToUpper(fields[1]))
+		if !ok {
+			return time.Time{}, false
+		}
+
+		if zone != nil {
+			loc = zone
+		}
+	}
+
+	if len(fields) == postgresTimestampFields {
+		zone, err := time.LoadLocation(fields[2])
+		if strings.EqualFold(fields[2], "utc") {
+			zone, err = time.UTC, nil
+		}
+
+		if err != nil {
+			return time.Time{}, false
+		}
+
+		loc = zone
+	}
+
+	return time.Date(year, month, day, hour, minute, sec, nsec, loc), true
+}
+
+// parsePostgresInterval parses PostgreSQL interval input in any of its
+// input styles, into months, days and an exact part as PostgreSQL stores
+// intervals:
+//
+//   - PostgreSQL style: "1 day 02:00:00", "1 mon 2 days", "@ 3 days ago",
+//     "1.5 hours", "-2 weeks". "ago" negates the whole interval.
+//   - ISO 8601 with designators: "P1Y2M3DT4H5M6S"
+//   - SQL standard: "1-2" (years-months), "3 4:05:06" (days and time),
+//     "4:05:06", "1:02.5" (minutes and seconds), or a bare number of seconds
+//
+// As in PostgreSQL, fractional months and days cascade into days and time
+// ("1.5 mons" is 1 month 15 days), fractional years round to whole months,
+// and each field keeps its own sign.
+func parsePostgresInterval(s string) (span, error) {
+	if looksLikeISODuration(s) {
+		return parseISODuration(s)
+	}
+
+	fields := splitPostgresFields(strings.TrimSpace(strings.TrimPrefix(s, "@")))
+	if len(fields) == 0 {
+		return span{}, fmt.Errorf("%w: empty interval", ErrInvalidDuration)
+	}
+
+	sign := 1
+	if fields[len(fields)-1] == "ago" {
+		sign, fields = -1, fields[:len(fields)-1]
+	}
+
+	var result span
+
+	exact := new(big.Rat)
+
+	for len(fields) > 0 {
+		var err error
+
+		fields, err = addPostgresField(&result, exact, fields)
+		if err != nil {
+			return span{}, err
+		}
+	}
+
+	nanos := new(big.Int).Quo(exact.Num(), exact.Denom())
+	if !nanos.IsInt64() {
+		return span{}, fmt.Errorf("%w: %q overflows", ErrInvalidDuration, s)
+	}
+
+	result.exact = time.Duration(nanos.Int64())
+
+	return span{
+		months: sign * result.months,
+		days:   sign * result.days,
+		exact:  time.Duration(sign) * result.exact,
+	}, nil
+}
+
+// addPostgresField adds the interval field fields starts with to result and
+// exact, and returns the remaining fields. A number takes the unit written
+// after it.
+func addPostgresField(result *span, exact *big.Rat, fields []string) ([]string, error) {
+	field, rest := fields[0], fields[1:]
+
+	switch {
+	case strings.Contains(field, ":"):
+		d, ok := parsePostgresTime(field)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid time %q", ErrInvalidDuration, field)
+		}
+
+		exact.Add(exact, d)
+
+		return rest, nil
+	case strings.Contains(strings.TrimLeft(field, "+-"), "-"):
+		months, ok := parsePostgresYearMonth(field)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid year-month %q", ErrInvalidDuration, field)
+		}
+
+		result.months += months
+
+		return rest, nil
+	}
+
+	amount, ok := new(big.Rat).SetString(field)
+	if !ok || strings.ContainsAny(field, "/eE") {
+		return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidDuration, field)
+	}
+
+	unit, rest, err := cutPostgresUnit(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	addPostgresAmount(result, exact, amount, unit)
+
+	return rest, nil
+}
+
+// cutPostgresUnit returns the unit of a number from the fields after it:
+// the unit named next, days when a time follows as in "3 4:05:06", and
+// seconds otherwise.
+func cutPostgresUnit(rest []string) (postgresUnit, []string, error) {
+	if len(rest) == 0 {
+		return postgresUnit{exact: time.Second}, rest, nil
+	}
+
+	next := rest[0]
+
+	switch {
+	case !isDigit(next[0]) && next[0] != '+' && next[0] != '-' && next[0] != '.':
+		unit, ok := lookupPostgresUnit(next)
+		if !ok {
+			return postgresUnit{}, nil, fmt.Errorf("%w: unknown unit %q", ErrInvalidDuration, next)
+		}
+
+		return unit, rest[1:], nil
+	case strings.Contains(next, ":"):
+		return postgresUnit{days: 1}, rest, nil
+	default:
+		return postgresUnit{exact: time.Second}, rest, nil
+	}
+}
+
+// splitPostgresFields splits interval input into fields, separating numbers
+// from the units written right after them, as in "1day".
+func splitPostgresFields(s string) []string {
+	var fields []string
+
+	for _, field := range strings.Fields(s) {
+		for field != "" {
+			if word, rest := cutWord(field); word != "" {
+				fields = append(fields, word)
+				field = rest
+
+				continue
+			}
+
+			end := strings.IndexFunc(field, func(r rune) bool {
+				return r != '.' && r != ':' && r != '-' && r != '+' && (r < '0' || r > '9')
+			})
+			if end == -1 {
+				end = len(field)
+			}
+
+			if end == 0 {
+				// Keep unexpected characters so the field is rejected.
+				end = len(field)
+			}
+
+			fields = append(fields, field[:end])
+			field = field[end:]
+		}
+	}
+
+	return fields
+}
+
+// addPostgresAmount adds amount units to an interval, cascading fractions
+// the way PostgreSQL does.
+func addPostgresAmount(result *span, exact, amount *big.Rat, unit postgresUnit) {
+	switch {
+	case unit.years > 0:
+		months := new(big.Rat).Mul(amount, big.NewRat(int64(unit.years*monthsPerYear), 1))
+		result.months += roundRat(months)
+	case unit.months > 0:
+		months := new(big.Rat).Mul(amount, big.NewRat(int64(unit.months), 1))
+		whole := truncRat(months)
+		result.months += whole
+
+		days := new(big.Rat).Sub(months, big.NewRat(int64(whole), 1))
+		addPostgresAmount(result, exact, days.Mul(days, big.NewRat(postgresDaysPerMonth, 1)), postgresUnit{days: 1})
+	case unit.days > 0:
+		days := new(big.Rat).Mul(amount, big.NewRat(int64(unit.days), 1))
+		whole := truncRat(days)
+		result.days += whole
+
+		fraction := new(big.Rat).Sub(days, big.NewRat(int64(whole), 1))
+		exact.Add(exact, ratDuration(fraction, hoursPerDay*time.Hour))
+	default:
+		exact.Add(exact, ratDuration(amount, unit.exact))
+	}
+}
+
+// parsePostgresTime parses the time field of an interval: "[-]H:MM[:SS[.f]]",
+// or "M:SS.f" when there are two parts and a fraction. Hours are not limited
+// to a day. It returns nanoseconds.
+func parsePostgresTime(field string) (*big.Rat, bool) {
+	sign := int64(1)
+
+	switch field[0] {
+	case '-':
+		sign, field = -1, field[1:]
+	case '+':
+		field = field[1:]
+	}
+
+	parts := strings.Split(field, ":")
+	if len(parts) > clockParts {
+		return nil, false
+	}
+
+	lengths := []time.Duration{time.Hour, time.Minute, time.Second}
+	if len(parts) == clockParts-1 && strings.Contains(parts[1], ".") {
+		lengths = lengths[1:]
+	}
+
+	total := new(big.Rat)
+
+	for i, part := range parts {
+		amount, ok := new(big.Rat).SetString(part)
+		if !ok || part == "" || part[0] == '-' || part[0] == '+' || strings.ContainsAny(part, "/eE") ||
+			(i < len(parts)-1 && strings.Contains(part, ".")) {
+			return nil, false
+		}
+
+		// Fields after the first are minutes or seconds, and stay below 60.
+		if i > 0 && amount.Cmp(big.NewRat(minutesPerHour, 1)) >= 0 {
+			return nil, false
+		}
+
+		total.Add(total, ratDuration(amount, lengths[i]))
+	}
+
+	return total.Mul(total, big.NewRat(sign, 1)), true
+}
+
+// parsePostgresYearMonth parses a SQL-standard year-month field such as
+// "1-2" or "-1-2", returning months.
+func parsePostgresYearMonth(field string) (int, bool) {
+	sign := 1
+
+	switch field[0] {
+	case '-':
+		sign, field = -1, field[1:]
+	case '+':
+		field = field[1:]
+	}
+
+	parts := strings.Split(field, "-")
+	if len(parts) != postgresYearMonthParts || !isAllDigits(parts[0]) || !isAllDigits(parts[1]) {
+		return 0, false
+	}
+
+	years, okYears := parseSignedInt(parts[0])
+	months, okMonths := parseSignedInt(parts[1])
+
+	return sign * (years*monthsPerYear + months), okYears && okMonths
+}
+
+// addPostgresInterval adds an interval to t the way PostgreSQL adds one to
+// a timestamp: months first, clamping the day to the end of the month, then
+// days, then the exact part.
+func addPostgresInterval(t time.Time, interval span) time.Time {
+	return addMonthsClamped(t, interval.months).AddDate(0, 0, interval.days).Add(interval.exact)
+}
+
+// parsePostgresRange parses a range literal such as
+// "[2025-12-01,2025-12-10)", as PostgreSQL writes tstzrange values. Empty
+// bounds and infinite bounds are open ends. The brackets only mark the
+// literal: both bounds are returned as written.
+func (p *Parser) parsePostgresRange(timeRange string, now time.Time) (Range, bool, error) {
+	s := strings.Trim(strings.TrimSpace(timeRange), "'")
+	if len(s) < 2 || strings.IndexByte("[(", s[0]) < 0 || strings.IndexByte("])", s[len(s)-1]) < 0 {
+		return Range{}, false, nil
+	}
+
+	from, to, found := strings.Cut(s[1:len(s)-1], ",")
+	if !found {
+		return Range{}, true, fmt.Errorf("%w: missing comma in %q", ErrInvalidTimeRange, timeRange)
+	}
+
+	r, err := p.parseBoundsAt(strings.Trim(strings.TrimSpace(from), `"`), strings.Trim(strings.TrimSpace(to), `"`), now)
+
+	return r, true, err
+}
+
+// truncRat returns r truncated toward zero.
+func truncRat(r *big.Rat) int {
+	return int(new(big.Int).Quo(r.Num(), r.Denom()).Int64())
+}
+
+// roundRat returns r rounded to the nearest integer, halves to even, as
+// PostgreSQL's rint does.
+func roundRat(r *big.Rat) int {
+	whole := truncRat(r)
+	fraction := new(big.Rat).Sub(r, big.NewRat(int64(whole), 1))
+
+	cmp := fraction.Abs(fraction).Cmp(big.NewRat(1, 2))
+	if cmp > 0 || (cmp == 0 && whole%2 != 0) {
+		whole += r.Sign()
+	}
+
+	return whole
+}
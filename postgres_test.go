@@ -0,0 +1,182 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime_PostgresIntervals(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	parser := NewParser(WithDialect(PostgresDialect))
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		// PostgreSQL style.
+		{input: "1 day 02:00:00", expected: now.Add(26 * time.Hour)},
+		{input: "1 mon 2 days", expected: time.Date(2026, 1, 12, 15, 30, 45, 0, time.UTC)},
+		{input: "@ 3 days ago", expected: now.AddDate(0, 0, -3)},
+		{input: "1 day ago", expected: now.AddDate(0, 0, -1)},
+		{input: "2 hours 30 minutes ago", expected: now.Add(-150 * time.Minute)},
+		{input: "-2 weeks", expected: now.AddDate(0, 0, -14)},
+		{input: "1day", expected: now.AddDate(0, 0, 1)},
+		{input: "1.5 mons", expected: time.Date(2026, 1, 25, 15, 30, 45, 0, time.UTC)},
+		{input: "1.5 days", expected: now.Add(36 * time.Hour)},
+		{input: "1.5 years", expected: time.Date(2027, 6, 10, 15, 30, 45, 0, time.UTC)},
+		// Fractional years round to months half to even, as rint does.
+		{input: "0.375 years", expected: time.Date(2026, 4, 10, 15, 30, 45, 0, time.UTC)},
+		{input: "1.125 years", expected: time.Date(2027, 2, 10, 15, 30, 45, 0, time.UTC)},
+		{input: "-0.375 years", expected: time.Date(2025, 8, 10, 15, 30, 45, 0, time.UTC)},
+		{input: "1 millennium", expected: now.AddDate(1000, 0, 0)},
+		{input: "500 ms", expected: now.Add(500 * time.Millisecond)},
+		// ISO 8601 with designators.
+		{input: "P1Y2M3DT4H5M6S", expected: time.Date(2027, 2, 13, 19, 35, 51, 0, time.UTC)},
+		{input: "PT90M", expected: now.Add(90 * time.Minute)},
+		// SQL standard.
+		{input: "1-2", expected: time.Date(2027, 2, 10, 15, 30, 45, 0, time.UTC)},
+		{input: "3 4:05:06", expected: now.AddDate(0, 0, 3).Add(4*time.Hour + 5*time.Minute + 6*time.Second)},
+		{input: "4:05:06", expected: now.Add(4*time.Hour + 5*time.Minute + 6*time.Second)},
+		{input: "-4:05", expected: now.Add(-4*time.Hour - 5*time.Minute)},
+		{input: "1:02.5", expected: now.Add(62500 * time.Millisecond)},
+		{input: "100:00:00", expected: now.Add(100 * time.Hour)},
+		{input: "3", expected: now.Add(3 * time.Second)},
+		{input: "1 day 2", expected: now.AddDate(0, 0, 1).Add(2 * time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := parser.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+
+	t.Run("months clamp to the end of the month", func(t *testing.T) {
+		result, err := parser.ParseTime("1 mon", time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC), time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC), result)
+	})
+
+	for _, input := range []string{"1 fortnight", "garbage", "1 day ago 2 hours", "25:61:00", "1-13x", "days"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parser.ParseTime(input, now, time.Time{})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+}
+
+func TestParseTime_PostgresTimestamps(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	parser := NewParser(WithDialect(PostgresDialect))
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "now", expected: now},
+		{input: "today", expected: midnight(2025, 12, 10)},
+		{input: "yesterday", expected: midnight(2025, 12, 9)},
+		{input: "tomorrow", expected: midnight(2025, 12, 11)},
+		{input: "epoch", expected: time.Unix(0, 0)},
+		{input: "2025-12-01", expected: midnight(2025, 12, 1)},
+		{input: "'2025-12-01'", expected: midnight(2025, 12, 1)},
+		{input: "2025-12-01 14:00:00", expected: time.Date(2025, 12, 1, 14, 0, 0, 0, time.UTC)},
+		{input: "2025-12-01 14:00:00.5", expected: time.Date(2025, 12, 1, 14, 0, 0, 500000000, time.UTC)},
+		{input: "2025-12-01T14:00:00Z", expected: time.Date(2025, 12, 1, 14, 0, 0, 0, time.UTC)},
+		{input: "2025-12-01 14:00:00+02", expected: time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC)},
+		{input: "2025-12-01 14:00 UTC", expected: time.Date(2025, 12, 1, 14, 0, 0, 0, time.UTC)},
+		{input: "2025-12-01 14:00 America/New_York", expected: time.Date(2025, 12, 1, 14, 0, 0, 0, newYork)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := parser.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+
+	for _, input := range []string{"2025-13-01", "2025-02-30", "2025-12-01 25:00", "2025-12-01 14:00 Nowhere/City"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parser.ParseTime(input, now, time.Time{})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParseRange_PostgresInfinity(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	parser := NewParser(WithDialect(PostgresDialect), WithClock(fixedTime))
+
+	r, err := parser.ParseBounds("-infinity", "infinity")
+	require.NoError(t, err)
+	assert.Equal(t, Range{}, r)
+
+	r, err = parser.ParseBounds("2025-12-01", "infinity")
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: midnight(2025, 12, 1)}, r)
+
+	_, err = parser.ParseBounds("infinity", "now")
+	assert.True(t, errors.Is(err, ErrInvalidStartTime))
+
+	_, err = parser.ParseBounds("now", "-infinity")
+	assert.True(t, errors.Is(err, ErrInvalidEndTime))
+
+	// On their own, the infinities have no time.Time value.
+	singles := []struct {
+		input string
+		err   error
+	}{
+		{input: "infinity", err: ErrInfinity},
+		{input: "+infinity", err: ErrInfinity},
+		{input: "'infinity'", err: ErrInfinity},
+		{input: "-infinity", err: ErrNegativeInfinity},
+	}
+
+	for _, tt := range singles {
+		t.Run("single "+tt.input, func(t *testing.T) {
+			_, err := parser.ParseTime(tt.input, fixedTime(), time.Time{})
+			assert.True(t, errors.Is(err, tt.err))
+
+			_, err = parser.ParseRange(tt.input)
+			assert.True(t, errors.Is(err, tt.err))
+		})
+	}
+
+	tests := []struct {
+		input string
+		start time.Time
+		end   time.Time
+	}{
+		{input: "[2025-12-01,2025-12-10)", start: midnight(2025, 12, 1), end: midnight(2025, 12, 10)},
+		{input: "[,2025-12-10)", end: midnight(2025, 12, 10)},
+		{input: `["2025-12-01 00:00:00+00",infinity)`, start: midnight(2025, 12, 1)},
+		{input: "(-infinity,infinity)"},
+		{input: "['7 days ago', now]", start: fixedTime().AddDate(0, 0, -7), end: fixedTime()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := parser.ParseRange(tt.input)
+			require.NoError(t, err)
+			assert.True(t, tt.start.Equal(r.Start), "expected start %v, got %v", tt.start, r.Start)
+			assert.True(t, tt.end.Equal(r.End), "expected end %v, got %v", tt.end, r.End)
+		})
+	}
+
+	_, err = parser.ParseRange("[2025-12-10,2025-12-01)")
+	assert.True(t, errors.Is(err, ErrEndBeforeStart))
+
+	_, err = parser.ParseRange("[2025-12-10)")
+	assert.True(t, errors.Is(err, ErrInvalidTimeRange))
+}
@@ -0,0 +1,65 @@
+package friendlytime
+
+import "time"
+
+// Prefer selects how ambiguous inputs without a date are resolved: a bare
+// time of day ("15:30"), weekday ("friday"), month ("march") or day of the
+// month ("the 15th").
+type Prefer int
+
+const (
+	// PreferLiteral resolves ambiguous inputs in the current period: "15:30"
+	// is today, "friday" is in the current week, "march" in the current year
+	// and "the 15th" in the current month.
+	PreferLiteral Prefer = iota
+	// PreferPast resolves ambiguous inputs to the most recent match that is
+	// not in the future: at 10:00, "15:30" is yesterday at 15:30.
+	PreferPast
+	// PreferFuture resolves ambiguous inputs to the next match that is not
+	// in the past: at 16:00, "15:30" is tomorrow at 15:30.
+	PreferFuture
+	// PreferNearest resolves ambiguous inputs to the match closest to now,
+	// in either direction.
+	PreferNearest
+)
+
+// preferred picks among the candidates of an ambiguous input according to the
+// parser's preference. candidate(0) is the literal reading and candidate(n)
+// the one n periods later or earlier; reference is the time they are
+// compared to.
+func (p *Parser) preferred(candidate func(n int) time.Time, reference time.Time) time.Time {
+	literal := candidate(0)
+
+	switch p.prefer {
+	case PreferPast:
+		if literal.After(reference) {
+			return candidate(-1)
+		}
+	case PreferFuture:
+		if literal.Before(reference) {
+			return candidate(1)
+		}
+	case PreferNearest:
+		nearest := literal
+
+		for _, n := range []int{-1, 1} {
+			if t := candidate(n); absDuration(t.Sub(reference)) < absDuration(nearest.Sub(reference)) {
+				nearest = t
+			}
+		}
+
+		return nearest
+	case PreferLiteral:
+	}
+
+	return literal
+}
+
+// absDuration returns the absolute value of d.
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+
+	return d
+}
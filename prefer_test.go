@@ -0,0 +1,178 @@
+package friendlytime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_WithPrefer(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+
+	tests := []struct {
+		input   string
+		literal time.Time
+		past    time.Time
+		future  time.Time
+		nearest time.Time
+	}{
+		{
+			input:   "15:30",
+			literal: time.Date(2025, 12, 10, 15, 30, 0, 0, time.UTC),
+			past:    time.Date(2025, 12, 10, 15, 30, 0, 0, time.UTC),
+			future:  time.Date(2025, 12, 11, 15, 30, 0, 0, time.UTC),
+			nearest: time.Date(2025, 12, 10, 15, 30, 0, 0, time.UTC),
+		},
+		{
+			input:   "16:00",
+			literal: time.Date(2025, 12, 10, 16, 0, 0, 0, time.UTC),
+			past:    time.Date(2025, 12, 9, 16, 0, 0, 0, time.UTC),
+			future:  time.Date(2025, 12, 10, 16, 0, 0, 0, time.UTC),
+			nearest: time.Date(2025, 12, 10, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			input:   "03:00",
+			literal: time.Date(2025, 12, 10, 3, 0, 0, 0, time.UTC),
+			past:    time.Date(2025, 12, 10, 3, 0, 0, 0, time.UTC),
+			future:  time.Date(2025, 12, 11, 3, 0, 0, 0, time.UTC),
+			nearest: time.Date(2025, 12, 11, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			input:   "friday",
+			literal: midnight(2025, 12, 12),
+			past:    midnight(2025, 12, 5),
+			future:  midnight(2025, 12, 12),
+			nearest: midnight(2025, 12, 12),
+		},
+		{
+			input:   "Monday",
+			literal: midnight(2025, 12, 8),
+			past:    midnight(2025, 12, 8),
+			future:  midnight(2025, 12, 15),
+			nearest: midnight(2025, 12, 8),
+		},
+		{
+			input:   "wednesday",
+			literal: midnight(2025, 12, 10),
+			past:    midnight(2025, 12, 10),
+			future:  midnight(2025, 12, 10),
+			nearest: midnight(2025, 12, 10),
+		},
+		{
+			input:   "march",
+			literal: midnight(2025, 3, 1),
+			past:    midnight(2025, 3, 1),
+			future:  midnight(2026, 3, 1),
+			nearest: midnight(2026, 3, 1),
+		},
+		{
+			input:   "december",
+			literal: midnight(2025, 12, 1),
+			past:    midnight(2025, 12, 1),
+			future:  midnight(2025, 12, 1),
+			nearest: midnight(2025, 12, 1),
+		},
+		{
+			input:   "march 2024",
+			literal: midnight(2024, 3, 1),
+			past:    midnight(2024, 3, 1),
+			future:  midnight(2024, 3, 1),
+			nearest: midnight(2024, 3, 1),
+		},
+		{
+			input:   "the 15th",
+			literal: midnight(2025, 12, 15),
+			past:    midnight(2025, 11, 15),
+			future:  midnight(2025, 12, 15),
+			nearest: midnight(2025, 12, 15),
+		},
+		{
+			input:   "1st",
+			literal: midnight(2025, 12, 1),
+			past:    midnight(2025, 12, 1),
+			future:  midnight(2026, 1, 1),
+			nearest: midnight(2025, 12, 1),
+		},
+	}
+
+	parsers := []struct {
+		name   string
+		parser *Parser
+		pick   func(literal, past, future, nearest time.Time) time.Time
+	}{
+		{name: "literal", parser: NewParser(), pick: func(l, _, _, _ time.Time) time.Time { return l }},
+		{name: "past", parser: NewParser(WithPrefer(PreferPast)), pick: func(_, p, _, _ time.Time) time.Time { return p }},
+		{name: "future", parser: NewParser(WithPrefer(PreferFuture)), pick: func(_, _, f, _ time.Time) time.Time { return f }},
+		{name: "nearest", parser: NewParser(WithPrefer(PreferNearest)), pick: func(_, _, _, n time.Time) time.Time { return n }},
+	}
+
+	for _, tt := range tests {
+		for _, p := range parsers {
+			t.Run(p.name+" "+tt.input, func(t *testing.T) {
+				result, err := p.parser.ParseTime(tt.input, now, time.Time{})
+				require.NoError(t, err)
+				assert.Equal(t, p.pick(tt.literal, tt.past, tt.future, tt.nearest), result)
+			})
+		}
+	}
+}
+
+func TestParser_WithPrefer_LateDaysOfMonth(t *testing.T) {
+	past := NewParser(WithPrefer(PreferPast))
+	future := NewParser(WithPrefer(PreferFuture))
+
+	// Months too short for the day are skipped rather than rolled over.
+	tests := []struct {
+		now      time.Time
+		input    string
+		parser   *Parser
+		expected time.Time
+	}{
+		{now: fixedTime(), input: "the 29th", parser: past, expected: midnight(2025, 11, 29)},
+		{now: fixedTime(), input: "the 30th", parser: past, expected: midnight(2025, 11, 30)},
+		{now: fixedTime(), input: "the 31st", parser: past, expected: midnight(2025, 10, 31)},
+		{now: fixedTime(), input: "the 29th", parser: future, expected: midnight(2025, 12, 29)},
+		{now: fixedTime(), input: "the 30th", parser: future, expected: midnight(2025, 12, 30)},
+		{now: fixedTime(), input: "the 31st", parser: future, expected: midnight(2025, 12, 31)},
+		{now: midnight(2025, 3, 10), input: "the 29th", parser: past, expected: midnight(2025, 1, 29)},
+		{now: midnight(2025, 3, 10), input: "the 30th", parser: past, expected: midnight(2025, 1, 30)},
+		{now: midnight(2025, 3, 10), input: "the 31st", parser: past, expected: midnight(2025, 1, 31)},
+		{now: midnight(2025, 2, 10), input: "the 29th", parser: future, expected: midnight(2025, 3, 29)},
+		{now: midnight(2025, 2, 10), input: "the 30th", parser: future, expected: midnight(2025, 3, 30)},
+		{now: midnight(2025, 2, 10), input: "the 31st", parser: future, expected: midnight(2025, 3, 31)},
+		{now: midnight(2025, 2, 10), input: "the 30th", parser: past, expected: midnight(2025, 1, 30)},
+		{now: midnight(2024, 2, 10), input: "the 29th", parser: future, expected: midnight(2024, 2, 29)},
+		{now: midnight(2025, 11, 10), input: "the 31st", parser: NewParser(), expected: midnight(2025, 12, 31)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.now.Format("2006-01-02")+" "+tt.input, func(t *testing.T) {
+			result, err := tt.parser.ParseTime(tt.input, tt.now, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestParser_WithPrefer_PastTimeOfDay(t *testing.T) {
+	now := time.Date(2025, 12, 10, 10, 0, 0, 0, time.UTC)
+
+	result, err := NewParser(WithPrefer(PreferPast)).ParseTime("15:30", now, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 12, 9, 15, 30, 0, 0, time.UTC), result)
+}
+
+func TestParseRange_Months(t *testing.T) {
+	parser := NewParser(WithClock(fixedTime), WithPrefer(PreferFuture))
+
+	r, err := parser.ParseRange("march")
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: midnight(2026, 3, 1), End: midnight(2026, 4, 1)}, r)
+
+	r, err = parser.ParseRange("jan 2025/march 2025")
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: midnight(2025, 1, 1), End: midnight(2025, 4, 1)}, r)
+}
@@ -0,0 +1,202 @@
+package friendlytime
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// prometheusMaxPoints is the most points per series Prometheus evaluates in
+// a range query.
+const prometheusMaxPoints = 11000
+
+// prometheusUnit is a unit of Prometheus duration syntax.
+type prometheusUnit struct {
+	name   string
+	length time.Duration
+}
+
+// getPrometheusUnits returns the units of Prometheus durations, in the order
+// they must appear.
+func getPrometheusUnits() []prometheusUnit {
+	return []prometheusUnit{
+		{name: "y", length: hoursPerYear * time.Hour},
+		{name: "w", length: daysPerWeek * hoursPerDay * time.Hour},
+		{name: "d", length: hoursPerDay * time.Hour},
+		{name: "h", length: time.Hour},
+		{name: "m", length: time.Minute},
+		{name: "s", length: time.Second},
+		{name: "ms", length: time.Millisecond},
+	}
+}
+
+// ParsePrometheusRange parses the start, end and step parameters of a
+// Prometheus range query the way /api/v1/query_range does, and returns the
+// range of evaluation timestamps together with the step.
+//
+// ParsePrometheusRange uses the default options; see Parser for configurable parsing.
+func ParsePrometheusRange(start, end, step string) (Range, time.Duration, error) {
+	return defaultParser.ParsePrometheusRange(start, end, step)
+}
+
+// ParsePrometheusRange parses the parameters of a Prometheus range query.
+// Start and end are RFC 3339 timestamps or Unix seconds with up to
+// millisecond precision, such as "1416434697.123". Step is a duration such
+// as "15s" or "1h30m", or a number of seconds such as "0.5".
+//
+// As in Prometheus, the end may not be before the start, the step must be
+// positive, and a query may not have more than 11,000 points per series.
+// The returned range starts at start and ends at the last evaluation
+// timestamp, start plus a whole number of steps; Range.End is that last
+// point rather than an exclusive end.
+//
+// ParsePrometheusRange reads Prometheus syntax whatever the parser's
+// dialect; times are converted to the parser's location.
+func (p *Parser) ParsePrometheusRange(start, end, step string) (Range, time.Duration, error) {
+	startTime, err := parsePrometheusTime(start)
+	if err != nil {
+		return Range{}, 0, fmt.Errorf("%w: %w", ErrInvalidStartTime, err)
+	}
+
+	endTime, err := parsePrometheusTime(end)
+	if err != nil {
+		return Range{}, 0, fmt.Errorf("%w: %w", ErrInvalidEndTime, err)
+	}
+
+	if endTime.Before(startTime) {
+		return Range{}, 0, ErrEndBeforeStart
+	}
+
+	stepDuration, err := parsePrometheusStep(step)
+	if err != nil {
+		return Range{}, 0, err
+	}
+
+	if stepDuration <= 0 {
+		return Range{}, 0, fmt.Errorf("%w: zero or negative step %q", ErrInvalidStep, step)
+	}
+
+	points := endTime.Sub(startTime) / stepDuration
+	if points > prometheusMaxPoints {
+		return Range{}, 0, fmt.Errorf(
+			"%w: %d points with step %s, the maximum is %d",
+			ErrMaxResolution, points, stepDuration, prometheusMaxPoints,
+		)
+	}
+
+	lastPoint := startTime.Add(points * stepDuration)
+
+	return Range{Start: p.localize(startTime), End: p.localize(lastPoint)}, stepDuration, nil
+}
+
+// parsePrometheus parses a Prometheus timestamp for the Prometheus dialect.
+// An empty string is an open side of a range, as an omitted start or end
+// is in Prometheus' series and label APIs.
+func (p *Parser) parsePrometheus(timeStr string) (time.Time, error) {
+	timeStr = strings.TrimSpace(timeStr)
+	if timeStr == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := parsePrometheusTime(timeStr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return p.localize(t), nil
+}
+
+// parsePrometheusTime parses a timestamp as the Prometheus HTTP API does:
+// Unix seconds, rounded to milliseconds, or RFC 3339.
+func parsePrometheusTime(s string) (time.Time, error) {
+	if seconds, err := strconv.ParseFloat(s, 64); err == nil {
+		if math.IsInf(seconds, 0) || math.IsNaN(seconds) {
+			return time.Time{}, fmt.Errorf("%w: %q is not a finite timestamp", ErrInvalidTimeFormat, s)
+		}
+
+		whole, fraction := math.Modf(seconds)
+		fraction = math.Round(fraction*millisecondsPerSecond) / millisecondsPerSecond
+
+		return time.Unix(int64(whole), int64(fraction*float64(time.Second))).UTC(), nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: cannot parse %q to a valid timestamp", ErrInvalidTimeFormat, s)
+	}
+
+	return t, nil
+}
+
+// parsePrometheusStep parses a query resolution step: a number of seconds,
+// which may be fractional, or a Prometheus duration.
+func parsePrometheusStep(s string) (time.Duration, error) {
+	if seconds, err := strconv.ParseFloat(s, 64); err == nil {
+		nanos := seconds * float64(time.Second)
+		if math.IsNaN(nanos) || nanos > math.MaxInt64 || nanos < math.MinInt64 {
+			return 0, fmt.Errorf("%w: %q is out of range", ErrInvalidStep, s)
+		}
+
+		return time.Duration(nanos), nil
+	}
+
+	d, err := parsePrometheusDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidStep, err)
+	}
+
+	return d, nil
+}
+
+// parsePrometheusDuration parses a Prometheus duration such as "1h30m" or
+// "2w": whole amounts of y, w, d, h, m, s and ms, each used at most once
+// and in that order. Years are 365 days.
+func parsePrometheusDuration(s string) (time.Duration, error) {
+	if s == "0" {
+		return 0, nil
+	}
+
+	if s == "" {
+		return 0, fmt.Errorf("%w: empty duration string", ErrInvalidDuration)
+	}
+
+	var total time.Duration
+
+	units := getPrometheusUnits()
+
+	for rest := s; rest != ""; {
+		digits, tail := cutDigits(rest)
+		name, tail := cutWord(tail)
+
+		n, err := strconv.ParseInt(digits, 10, 64)
+		if err != nil || name == "" {
+			return 0, fmt.Errorf("%w: not a valid duration string: %q", ErrInvalidDuration, s)
+		}
+
+		index := -1
+
+		for i, unit := range units {
+			if unit.name == name {
+				index = i
+
+				break
+			}
+		}
+
+		if index < 0 {
+			return 0, fmt.Errorf("%w: not a valid duration string: %q", ErrInvalidDuration, s)
+		}
+
+		if n > math.MaxInt64/int64(units[index].length) {
+			return 0, fmt.Errorf("%w: %q overflows", ErrInvalidDuration, s)
+		}
+
+		total += time.Duration(n) * units[index].length
+		units = units[index+1:]
+		rest = tail
+	}
+
+	return total, nil
+}
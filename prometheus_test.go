@@ -0,0 +1,154 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePrometheusRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    string
+		end      string
+		step     string
+		want     Range
+		wantStep time.Duration
+	}{
+		{
+			name:     "float seconds",
+			start:    "1416434697.123",
+			end:      "1416438297.123",
+			step:     "60",
+			want:     Range{Start: time.UnixMilli(1416434697123), End: time.UnixMilli(1416438297123)},
+			wantStep: time.Minute,
+		},
+		{
+			name:  "end aligned to the step",
+			start: "2025-12-10T15:00:00Z",
+			end:   "2025-12-10T15:59:59Z",
+			step:  "15m",
+			want: Range{
+				Start: time.Date(2025, 12, 10, 15, 0, 0, 0, time.UTC),
+				End:   time.Date(2025, 12, 10, 15, 45, 0, 0, time.UTC),
+			},
+			wantStep: 15 * time.Minute,
+		},
+		{
+			name:  "RFC 3339 with offset",
+			start: "2025-12-10T16:00:00+01:00",
+			end:   "2025-12-10T16:30:00.5+01:00",
+			step:  "1m30s",
+			want: Range{
+				Start: time.Date(2025, 12, 10, 15, 0, 0, 0, time.UTC),
+				End:   time.Date(2025, 12, 10, 15, 30, 0, 0, time.UTC),
+			},
+			wantStep: 90 * time.Second,
+		},
+		{
+			name:     "fractional step",
+			start:    "0",
+			end:      "2",
+			step:     "0.5",
+			want:     Range{Start: time.Unix(0, 0), End: time.Unix(2, 0)},
+			wantStep: 500 * time.Millisecond,
+		},
+		{
+			name:     "sub-millisecond digits are rounded",
+			start:    "1.0004",
+			end:      "1.0014",
+			step:     "1ms",
+			want:     Range{Start: time.Unix(1, 0), End: time.UnixMilli(1001)},
+			wantStep: time.Millisecond,
+		},
+		{
+			name:     "exactly the maximum resolution",
+			start:    "0",
+			end:      "11000",
+			step:     "1s",
+			want:     Range{Start: time.Unix(0, 0), End: time.Unix(11000, 0)},
+			wantStep: time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, step, err := ParsePrometheusRange(tt.start, tt.end, tt.step)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Start.Equal(r.Start), "expected start %v, got %v", tt.want.Start, r.Start)
+			assert.True(t, tt.want.End.Equal(r.End), "expected end %v, got %v", tt.want.End, r.End)
+			assert.Equal(t, tt.wantStep, step)
+		})
+	}
+
+	errorTests := []struct {
+		name  string
+		start string
+		end   string
+		step  string
+		err   error
+	}{
+		{name: "invalid start", start: "yesterday", end: "0", step: "1s", err: ErrInvalidStartTime},
+		{name: "invalid end", start: "0", end: "2025-12-10", step: "1s", err: ErrInvalidEndTime},
+		{name: "end before start", start: "10", end: "5", step: "1s", err: ErrEndBeforeStart},
+		{name: "zero step", start: "0", end: "10", step: "0", err: ErrInvalidStep},
+		{name: "negative step", start: "0", end: "10", step: "-1", err: ErrInvalidStep},
+		{name: "invalid step", start: "0", end: "10", step: "1x", err: ErrInvalidStep},
+		{name: "units out of order", start: "0", end: "10", step: "1s1m", err: ErrInvalidStep},
+		{name: "too many points", start: "0", end: "11001", step: "1s", err: ErrMaxResolution},
+		{name: "infinite start", start: "+Inf", end: "10", step: "1s", err: ErrInvalidStartTime},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ParsePrometheusRange(tt.start, tt.end, tt.step)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.err), "expected %v, got %v", tt.err, err)
+		})
+	}
+}
+
+func TestParsePrometheusDuration(t *testing.T) {
+	tests := map[string]time.Duration{
+		"0":       0,
+		"15s":     15 * time.Second,
+		"1h30m":   90 * time.Minute,
+		"2w":      14 * 24 * time.Hour,
+		"1y":      365 * 24 * time.Hour,
+		"1d12h":   36 * time.Hour,
+		"1s500ms": 1500 * time.Millisecond,
+	}
+
+	for input, expected := range tests {
+		t.Run(input, func(t *testing.T) {
+			d, err := parsePrometheusDuration(input)
+			require.NoError(t, err)
+			assert.Equal(t, expected, d)
+		})
+	}
+
+	for _, input := range []string{"", "1", "1.5h", "1h1h", "1m1h", "h", "1mo"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parsePrometheusDuration(input)
+			assert.True(t, errors.Is(err, ErrInvalidDuration))
+		})
+	}
+}
+
+func TestParseBounds_Prometheus(t *testing.T) {
+	parser := NewParser(WithDialect(PrometheusDialect), WithLocation(time.UTC))
+
+	r, err := parser.ParseBounds("1416434697.123", "2025-12-10T15:30:45Z")
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: time.UnixMilli(1416434697123).UTC(), End: fixedTime()}, r)
+
+	r, err = parser.ParseBounds("", "1416434697")
+	require.NoError(t, err)
+	assert.Equal(t, Range{End: time.Unix(1416434697, 0).UTC()}, r)
+
+	_, err = parser.ParseBounds("now", "")
+	assert.True(t, errors.Is(err, ErrInvalidStartTime))
+}
@@ -0,0 +1,75 @@
+package friendlytime
+
+import (
+	"strings"
+	"time"
+)
+
+// Range is a parsed time range.
+//
+// Start is inclusive. For period expressions such as "Q3 2025" End is the
+// exclusive end of the period; for single instants End equals Start. A zero
+// Start or End means the range is open on that side.
+type Range struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration returns the length of the range, or zero if either side is open.
+func (r Range) Duration() time.Duration {
+	if r.Start.IsZero() || r.End.IsZero() {
+		return 0
+	}
+
+	return r.End.Sub(r.Start)
+}
+
+// Contains reports whether t lies within the range. Open sides are
+// unbounded; the end is exclusive unless the range is a single instant.
+func (r Range) Contains(t time.Time) bool {
+	if !r.Start.IsZero() && t.Before(r.Start) {
+		return false
+	}
+
+	if r.End.IsZero() {
+		return true
+	}
+
+	if r.End.Equal(r.Start) {
+		return t.Equal(r.End)
+	}
+
+	return t.Before(r.End)
+}
+
+// ParseRange parses a time range like ParseTimeRange, but returns the
+// result as a Range of time.Time values.
+//
+// Period expressions expand to their full extent: "Q3 2025" is July 1 to
+// October 1, 2025, and "Q1/Q2" runs from the start of Q1 to the end of Q2.
+//
+// ParseRange uses the default options; see Parser for configurable parsing.
+func ParseRange(timeRange string) (Range, error) {
+	return defaultParser.ParseRange(timeRange)
+}
+
+// ParseRange parses a time range like the package-level ParseRange, using
+// the parser's options. With a dialect other than DefaultDialect the whole
+// string is one expression of that dialect; use ParseBounds for from/to pairs.
+func (p *Parser) ParseRange(timeRange string) (Range, error) {
+	if timeRange == "" {
+		return Range{}, nil
+	}
+
+	now := p.localize(p.clock())
+
+	if p.dialect != DefaultDialect {
+		return p.parseDialectRange(timeRange, now)
+	}
+
+	if !strings.Contains(timeRange, "/") {
+		return p.parseSingleTime(timeRange, now)
+	}
+
+	return p.parseTimeRangeParts(timeRange, now)
+}
@@ -0,0 +1,49 @@
+package friendlytime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRange_Contains(t *testing.T) {
+	period := Range{Start: midnight(2025, 7, 1), End: midnight(2025, 10, 1)}
+	instant := Range{Start: midnight(2025, 7, 1), End: midnight(2025, 7, 1)}
+	open := Range{Start: midnight(2025, 7, 1)}
+
+	assert.True(t, period.Contains(midnight(2025, 7, 1)))
+	assert.True(t, period.Contains(midnight(2025, 9, 30)))
+	assert.False(t, period.Contains(midnight(2025, 10, 1)))
+	assert.False(t, period.Contains(midnight(2025, 6, 30)))
+
+	assert.True(t, instant.Contains(midnight(2025, 7, 1)))
+	assert.False(t, instant.Contains(midnight(2025, 7, 2)))
+
+	assert.True(t, open.Contains(midnight(2030, 1, 1)))
+	assert.False(t, open.Contains(midnight(2025, 6, 30)))
+}
+
+func TestRange_Duration(t *testing.T) {
+	assert.Equal(t, 92*24*time.Hour, Range{Start: midnight(2025, 7, 1), End: midnight(2025, 10, 1)}.Duration())
+	assert.Equal(t, time.Duration(0), Range{End: midnight(2025, 10, 1)}.Duration())
+}
+
+func TestParseRange(t *testing.T) {
+	parser := NewParser(WithClock(fixedTime))
+
+	r, err := parser.ParseRange("")
+	require.NoError(t, err)
+	assert.Equal(t, Range{}, r)
+
+	r, err = parser.ParseRange("2h")
+	require.NoError(t, err)
+	assert.Equal(t, fixedTime().Add(-2*time.Hour), r.Start)
+	assert.Equal(t, r.Start, r.End)
+
+	r, err = parser.ParseRange("2h/1h")
+	require.NoError(t, err)
+	assert.Equal(t, fixedTime().Add(-2*time.Hour), r.Start)
+	assert.Equal(t, fixedTime().Add(-time.Hour), r.End)
+}
@@ -0,0 +1,365 @@
+package friendlytime
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// recurrenceParts is the number of "/"-separated parts of a repeating interval.
+	recurrenceParts = 3
+
+	// maxRecurrenceIndex bounds the occurrence indexes a Recurrence looks at,
+	// so that index arithmetic cannot overflow. Index math is done in int64 so
+	// the bound holds on 32-bit targets too.
+	maxRecurrenceIndex int64 = 1 << 40
+)
+
+// Recurrence is a parsed ISO 8601 repeating interval such as
+// "R5/2025-12-10T09:00Z/PT1H": a series of back-to-back occurrences of equal
+// nominal length.
+//
+// Occurrences repeat forward from the start of the interval, or backward from
+// its end when the interval is given as "<duration>/<end>". Months and days in
+// the duration follow the calendar. Month steps are counted from the anchor
+// and clamped to the end of shorter months, so "R12/2025-01-31/P1M" has one
+// occurrence per month: January 31, February 28, March 31, April 30 and so
+// on.
+type Recurrence struct {
+	// Count is the number of occurrences, or -1 if the recurrence repeats
+	// without end.
+	Count int
+
+	anchor   time.Time
+	step     span
+	backward bool
+}
+
+// ParseRecurrence parses an ISO 8601 repeating interval: "Rn/<interval>" with
+// n occurrences, or "R/<interval>" for an unbounded recurrence. The interval
+// may be "<start>/<end>", "<start>/<duration>" or "<duration>/<end>", and its
+// start and end may be any expression ParseTime accepts.
+//
+// ParseRecurrence uses the default options; see Parser for configurable parsing.
+func ParseRecurrence(s string) (*Recurrence, error) {
+	return defaultParser.ParseRecurrence(s)
+}
+
+// ParseRecurrence parses a repeating interval like the package-level
+// ParseRecurrence, using the parser's options.
+func (p *Parser) ParseRecurrence(s string) (*Recurrence, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != recurrenceParts {
+		return nil, fmt.Errorf("%w: %q is not of the form Rn/<interval>", ErrInvalidRecurrence, s)
+	}
+
+	count, err := parseRepetitions(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	now := p.localize(p.clock())
+
+	startDuration, startIsDuration, err := tryParseISOIntervalDuration(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w: %w", ErrInvalidRecurrence, ErrInvalidStartTime, err)
+	}
+
+	endDuration, endIsDuration, err := tryParseISOIntervalDuration(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w: %w", ErrInvalidRecurrence, ErrInvalidEndTime, err)
+	}
+
+	r := &Recurrence{Count: count}
+
+	switch {
+	case startIsDuration && endIsDuration:
+		return nil, fmt.Errorf("%w: the interval needs a start or an end", ErrInvalidRecurrence)
+	case startIsDuration:
+		r.anchor, err = p.parseBound(parts[2], now, time.Time{}, true)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w: %w", ErrInvalidRecurrence, ErrInvalidEndTime, err)
+		}
+
+		r.step, r.backward = startDuration, true
+	case endIsDuration:
+		r.anchor, err = p.parseBound(parts[1], now, time.Time{}, false)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w: %w", ErrInvalidRecurrence, ErrInvalidStartTime, err)
+		}
+
+		r.step = endDuration
+	default:
+		interval, err := p.parseTimeRangeParts(parts[1]+"/"+parts[2], now)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidRecurrence, err)
+		}
+
+		r.anchor, r.step = interval.Start, span{exact: interval.Duration()}
+	}
+
+	if next, ok := r.offset(1); !ok || !next.After(r.anchor) {
+		return nil, fmt.Errorf("%w: the interval must have a positive length", ErrInvalidRecurrence)
+	}
+
+	return r, nil
+}
+
+// parseRepetitions parses the "Rn" or "R" part of a repeating interval.
+func parseRepetitions(s string) (int, error) {
+	digits, ok := strings.CutPrefix(strings.ToLower(strings.TrimSpace(s)), "r")
+	if !ok {
+		return 0, fmt.Errorf("%w: %q does not start with R", ErrInvalidRecurrence, s)
+	}
+
+	if digits == "" {
+		return -1, nil
+	}
+
+	count, err := strconv.Atoi(digits)
+	if err != nil || count < 0 || digits[0] == '+' {
+		return 0, fmt.Errorf("%w: invalid number of repetitions %q", ErrInvalidRecurrence, s)
+	}
+
+	return count, nil
+}
+
+// Between returns the occurrences that overlap [from, to), in order.
+func (r *Recurrence) Between(from, to time.Time) []Range {
+	if !from.Before(to) {
+		return nil
+	}
+
+	k, ok := r.firstEndingAfter(from)
+	if !ok {
+		return nil
+	}
+
+	var occurrences []Range
+
+	for ; r.valid(k); k++ {
+		occurrence, ok := r.occurrence(k)
+		if !ok || !occurrence.Start.Before(to) {
+			break
+		}
+
+		occurrences = append(occurrences, occurrence)
+	}
+
+	return occurrences
+}
+
+// Containing returns the occurrence that contains t, if any.
+func (r *Recurrence) Containing(t time.Time) (Range, bool) {
+	k, ok := r.firstEndingAfter(t)
+	if !ok {
+		return Range{}, false
+	}
+
+	occurrence, ok := r.occurrence(k)
+	if !ok || occurrence.Start.After(t) {
+		return Range{}, false
+	}
+
+	return occurrence, true
+}
+
+// Next returns the first occurrence that starts after now, if any.
+func (r *Recurrence) Next(now time.Time) (Range, bool) {
+	k, ok := r.firstEndingAfter(now)
+	if !ok {
+		return Range{}, false
+	}
+
+	if occurrence, ok := r.occurrence(k); !ok || occurrence.Start.After(now) {
+		return occurrence, ok
+	}
+
+	if !r.valid(k + 1) {
+		return Range{}, false
+	}
+
+	return r.occurrence(k + 1)
+}
+
+// occurrence returns the k-th occurrence counted from the anchor. Occurrences
+// of a backward recurrence have negative indexes.
+func (r *Recurrence) occurrence(k int) (Range, bool) {
+	start, ok := r.offset(k)
+	if !ok {
+		return Range{}, false
+	}
+
+	end, ok := r.offset(k + 1)
+	if !ok {
+		return Range{}, false
+	}
+
+	return Range{Start: start, End: end}, true
+}
+
+// offset returns the anchor moved by k steps, or false if that time can't be
+// represented. Each offset is computed from the anchor, with months clamped
+// to the end of the month, so month steps don't drift after a short month.
+func (r *Recurrence) offset(k int) (time.Time, bool) {
+	months, monthsOK := mulIndex(k, r.step.months)
+	days, daysOK := mulIndex(k, r.step.days)
+
+	if !monthsOK || !daysOK {
+		return time.Time{}, false
+	}
+
+	t := addMonthsClamped(r.anchor, months).AddDate(0, 0, days)
+
+	return addSteps(t, k, r.step.exact)
+}
+
+// mulIndex multiplies an occurrence index by a step count, reporting false
+// if the index is out of range or the product overflows an int.
+func mulIndex(k, n int) (int, bool) {
+	index, count := int64(k), int64(n)
+	if index > maxRecurrenceIndex || index < -maxRecurrenceIndex {
+		return 0, false
+	}
+
+	product := index * count
+	if count != 0 && product/count != index {
+		return 0, false
+	}
+
+	if product > math.MaxInt || product < math.MinInt {
+		return 0, false
+	}
+
+	return int(product), true
+}
+
+// addSteps adds k times d to t. The product may exceed the range of
+// time.Duration, so it is added in seconds and nanoseconds.
+func addSteps(t time.Time, k int, d time.Duration) (time.Time, bool) {
+	if d == 0 || k == 0 {
+		return t, true
+	}
+
+	if total := time.Duration(k) * d; total/d == time.Duration(k) {
+		return t.Add(total), true
+	}
+
+	total := new(big.Int).Mul(big.NewInt(int64(k)), big.NewInt(int64(d)))
+
+	sec, nsec := new(big.Int).DivMod(total, big.NewInt(int64(time.Second)), new(big.Int))
+	if !sec.IsInt64() || !sec.Add(sec, big.NewInt(t.Unix())).IsInt64() {
+		return time.Time{}, false
+	}
+
+	return time.Unix(sec.Int64(), int64(t.Nanosecond())+nsec.Int64()).In(t.Location()), true
+}
+
+// valid reports whether k is the index of an occurrence.
+func (r *Recurrence) valid(k int) bool {
+	switch {
+	case r.backward && r.Count < 0:
+		return k < 0
+	case r.backward:
+		return k < 0 && k >= -r.Count
+	case r.Count < 0:
+		return k >= 0
+	default:
+		return k >= 0 && k < r.Count
+	}
+}
+
+// firstEndingAfter returns the index of the first occurrence that ends after t.
+func (r *Recurrence) firstEndingAfter(t time.Time) (int, bool) {
+	first, hasFirst := r.firstIndex()
+	if start, ok := r.offset(first); hasFirst && ok && start.After(t) {
+		return first, true
+	}
+
+	k, ok := r.estimateIndex(t)
+	if !ok {
+		return 0, false
+	}
+
+	// Calendar steps vary in length, so the estimate can be a little off.
+	for {
+		start, ok := r.offset(k)
+		if !ok {
+			return 0, false
+		}
+
+		if !start.After(t) {
+			break
+		}
+
+		k--
+	}
+
+	for {
+		end, ok := r.offset(k + 1)
+		if !ok {
+			return 0, false
+		}
+
+		if end.After(t) {
+			break
+		}
+
+		k++
+	}
+
+	// k is now the occurrence containing t; clamp it to the valid indexes.
+	if r.valid(k) {
+		return k, true
+	}
+
+	if hasFirst && k < first {
+		return first, true
+	}
+
+	return 0, false
+}
+
+// estimateIndex estimates the index of the occurrence containing t from the
+// length of the first step. Times are compared in seconds, since time.Time.Sub
+// saturates for times more than 292 years apart. It reports false if the
+// index is out of the range a Recurrence looks at.
+func (r *Recurrence) estimateIndex(t time.Time) (int, bool) {
+	next, ok := r.offset(1)
+	if !ok {
+		return 0, false
+	}
+
+	length := unixSeconds(next) - unixSeconds(r.anchor)
+	if length <= 0 {
+		return 0, false
+	}
+
+	k := math.Floor((unixSeconds(t) - unixSeconds(r.anchor)) / length)
+	if math.Abs(k) > float64(maxRecurrenceIndex) || k > math.MaxInt || k < math.MinInt {
+		return 0, false
+	}
+
+	return int(k), true
+}
+
+// unixSeconds returns t as fractional seconds since the Unix epoch.
+func unixSeconds(t time.Time) float64 {
+	return float64(t.Unix()) + float64(t.Nanosecond())/float64(time.Second)
+}
+
+// firstIndex returns the index of the earliest occurrence, if there is one.
+func (r *Recurrence) firstIndex() (int, bool) {
+	switch {
+	case r.Count == 0 || (r.backward && r.Count < 0):
+		return 0, false
+	case r.backward:
+		return -r.Count, true
+	default:
+		return 0, true
+	}
+}
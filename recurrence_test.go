@@ -0,0 +1,206 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func hourRange(day, from, to int) Range {
+	return Range{
+		Start: time.Date(2025, 12, day, from, 0, 0, 0, time.UTC),
+		End:   time.Date(2025, 12, day, to, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestParseRecurrence(t *testing.T) {
+	r, err := ParseRecurrence("R5/2025-12-10T09:00Z/PT1H")
+	require.NoError(t, err)
+	assert.Equal(t, 5, r.Count)
+
+	t.Run("between", func(t *testing.T) {
+		at := func(hour, minute int) time.Time { return time.Date(2025, 12, 10, hour, minute, 0, 0, time.UTC) }
+
+		assert.Equal(t, []Range{hourRange(10, 10, 11), hourRange(10, 11, 12)}, r.Between(at(10, 30), at(12, 0)))
+		assert.Len(t, r.Between(midnight(2025, 12, 9), midnight(2025, 12, 11)), 5)
+		assert.Empty(t, r.Between(at(14, 0), at(18, 0)))
+		assert.Empty(t, r.Between(at(12, 0), at(10, 0)))
+	})
+
+	t.Run("containing", func(t *testing.T) {
+		occurrence, ok := r.Containing(time.Date(2025, 12, 10, 10, 30, 0, 0, time.UTC))
+		require.True(t, ok)
+		assert.Equal(t, hourRange(10, 10, 11), occurrence)
+
+		occurrence, ok = r.Containing(time.Date(2025, 12, 10, 13, 0, 0, 0, time.UTC))
+		require.True(t, ok)
+		assert.Equal(t, hourRange(10, 13, 14), occurrence)
+
+		_, ok = r.Containing(time.Date(2025, 12, 10, 14, 0, 0, 0, time.UTC))
+		assert.False(t, ok)
+
+		_, ok = r.Containing(time.Date(2025, 12, 10, 8, 59, 0, 0, time.UTC))
+		assert.False(t, ok)
+	})
+
+	t.Run("next", func(t *testing.T) {
+		occurrence, ok := r.Next(time.Date(2025, 12, 10, 8, 0, 0, 0, time.UTC))
+		require.True(t, ok)
+		assert.Equal(t, hourRange(10, 9, 10), occurrence)
+
+		occurrence, ok = r.Next(time.Date(2025, 12, 10, 12, 30, 0, 0, time.UTC))
+		require.True(t, ok)
+		assert.Equal(t, hourRange(10, 13, 14), occurrence)
+
+		_, ok = r.Next(time.Date(2025, 12, 10, 13, 30, 0, 0, time.UTC))
+		assert.False(t, ok)
+	})
+}
+
+func TestParseRecurrence_Unbounded(t *testing.T) {
+	r, err := ParseRecurrence("R/2025-01-01/P1M")
+	require.NoError(t, err)
+	assert.Equal(t, -1, r.Count)
+
+	occurrence, ok := r.Containing(time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, Range{Start: midnight(2025, 6, 1), End: midnight(2025, 7, 1)}, occurrence)
+
+	occurrence, ok = r.Next(midnight(2030, 1, 15))
+	require.True(t, ok)
+	assert.Equal(t, Range{Start: midnight(2030, 2, 1), End: midnight(2030, 3, 1)}, occurrence)
+
+	occurrences := r.Between(midnight(2025, 11, 15), midnight(2026, 2, 1))
+	assert.Equal(t, []Range{
+		{Start: midnight(2025, 11, 1), End: midnight(2025, 12, 1)},
+		{Start: midnight(2025, 12, 1), End: midnight(2026, 1, 1)},
+		{Start: midnight(2026, 1, 1), End: midnight(2026, 2, 1)},
+	}, occurrences)
+}
+
+func TestParseRecurrence_IntervalForms(t *testing.T) {
+	t.Run("duration and end repeats backward", func(t *testing.T) {
+		r, err := ParseRecurrence("R3/P1D/2025-12-10")
+		require.NoError(t, err)
+
+		assert.Equal(t, []Range{
+			{Start: midnight(2025, 12, 7), End: midnight(2025, 12, 8)},
+			{Start: midnight(2025, 12, 8), End: midnight(2025, 12, 9)},
+			{Start: midnight(2025, 12, 9), End: midnight(2025, 12, 10)},
+		}, r.Between(midnight(2025, 12, 1), midnight(2025, 12, 31)))
+
+		_, ok := r.Containing(midnight(2025, 12, 10))
+		assert.False(t, ok)
+
+		occurrence, ok := r.Next(midnight(2025, 12, 1))
+		require.True(t, ok)
+		assert.Equal(t, midnight(2025, 12, 7), occurrence.Start)
+	})
+
+	t.Run("start and end", func(t *testing.T) {
+		r, err := ParseRecurrence("R2/2025-12-10T09:00Z/2025-12-10T10:30Z")
+		require.NoError(t, err)
+
+		assert.Equal(t, []Range{
+			{Start: time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC), End: time.Date(2025, 12, 10, 10, 30, 0, 0, time.UTC)},
+			{Start: time.Date(2025, 12, 10, 10, 30, 0, 0, time.UTC), End: time.Date(2025, 12, 10, 12, 0, 0, 0, time.UTC)},
+		}, r.Between(midnight(2025, 12, 10), midnight(2025, 12, 11)))
+	})
+
+	t.Run("no occurrences", func(t *testing.T) {
+		r, err := ParseRecurrence("R0/2025-12-10/P1D")
+		require.NoError(t, err)
+		assert.Empty(t, r.Between(midnight(2025, 1, 1), midnight(2026, 1, 1)))
+
+		_, ok := r.Next(midnight(2025, 1, 1))
+		assert.False(t, ok)
+	})
+
+	t.Run("relative start", func(t *testing.T) {
+		// Wednesday, December 10, 2025, 15:30:45
+		r, err := NewParser(WithClock(fixedTime)).ParseRecurrence("R/yesterday/P1D")
+		require.NoError(t, err)
+
+		occurrence, ok := r.Containing(fixedTime())
+		require.True(t, ok)
+		assert.Equal(t, Range{Start: midnight(2025, 12, 10), End: midnight(2025, 12, 11)}, occurrence)
+	})
+}
+
+func TestParseRecurrence_EndOfMonth(t *testing.T) {
+	r, err := ParseRecurrence("R12/2025-01-31/P1M")
+	require.NoError(t, err)
+
+	starts := make([]time.Time, 0, 4)
+	for _, occurrence := range r.Between(midnight(2025, 1, 1), midnight(2025, 5, 1)) {
+		starts = append(starts, occurrence.Start)
+	}
+
+	assert.Equal(t, []time.Time{
+		midnight(2025, 1, 31),
+		midnight(2025, 2, 28),
+		midnight(2025, 3, 31),
+		midnight(2025, 4, 30),
+	}, starts)
+
+	occurrence, ok := r.Containing(time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, Range{Start: midnight(2025, 2, 28), End: midnight(2025, 3, 31)}, occurrence)
+}
+
+func TestParseRecurrence_FarBounds(t *testing.T) {
+	r, err := ParseRecurrence("R/2025-12-10T09:00Z/PT1M")
+	require.NoError(t, err)
+
+	first := Range{
+		Start: time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC),
+		End:   time.Date(2025, 12, 10, 9, 1, 0, 0, time.UTC),
+	}
+	to := time.Date(2025, 12, 10, 9, 1, 0, 0, time.UTC)
+
+	started := time.Now()
+
+	assert.Equal(t, []Range{first}, r.Between(time.Time{}, to))
+	assert.Equal(t, []Range{first}, r.Between(to.AddDate(-300, 0, 0), to))
+
+	occurrence, ok := r.Next(time.Time{})
+	require.True(t, ok)
+	assert.Equal(t, first, occurrence)
+
+	// 400 years of minutes is more than a time.Duration can hold.
+	later := time.Date(2425, 12, 10, 9, 0, 30, 0, time.UTC)
+	occurrence, ok = r.Containing(later)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2425, 12, 10, 9, 0, 0, 0, time.UTC), occurrence.Start)
+
+	backward, err := ParseRecurrence("R/PT1M/2025-12-10T09:00Z")
+	require.NoError(t, err)
+	assert.Len(t, backward.Between(to.AddDate(-300, 0, 0), to.AddDate(-300, 0, 0).Add(5*time.Minute)), 5)
+
+	assert.Less(t, time.Since(started), time.Second)
+}
+
+func TestParseRecurrence_Errors(t *testing.T) {
+	inputs := []string{
+		"5/2025-12-10/PT1H",
+		"R5/2025-12-10",
+		"R5/P1D/PT1H",
+		"R-1/2025-12-10/PT1H",
+		"Rx/2025-12-10/PT1H",
+		"R5/2025-12-10/PT0S",
+		"R5/2025-12-11/2025-12-10",
+		"R5/nonsense/PT1H",
+		"R5/2025-12-10/P1X",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseRecurrence(input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidRecurrence))
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package friendlytime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// splunkTimeFormat is Splunk's default time format, %m/%d/%Y:%H:%M:%S.
+const splunkTimeFormat = "01/02/2006:15:04:05"
+
+// splunkUnit is a time unit of Splunk time modifiers.
+type splunkUnit struct {
+	names []string
+	unit  byte
+}
+
+// getSplunkUnits returns the time units of Splunk time modifiers.
+func getSplunkUnits() []splunkUnit {
+	return []splunkUnit{
+		{names: []string{"s", "sec", "secs", "second", "seconds"}, unit: unitSecond},
+		{names: []string{"m", "min", "mins", "minute", "minutes"}, unit: unitMinute},
+		{names: []string{"h", "hr", "hrs", "hour", "hours"}, unit: unitHour},
+		{names: []string{"d", "day", "days"}, unit: unitDay},
+		{names: []string{"w", "week", "weeks"}, unit: unitWeek},
+		{names: []string{"mon", "month", "months"}, unit: unitMonth},
+		{names: []string{"q", "qtr", "qtrs", "quarter", "quarters"}, unit: unitQuarter},
+		{names: []string{"y", "yr", "yrs", "year", "years"}, unit: unitYear},
+	}
+}
+
+// lookupSplunkUnit finds the Splunk time unit with the given name.
+func lookupSplunkUnit(name string) (byte, bool) {
+	for _, unit := range getSplunkUnits() {
+		for _, unitName := range unit.names {
+			if unitName == name {
+				return unit.unit, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// parseSplunk parses a Splunk time modifier the way Splunk evaluates
+// earliest and latest: "now", a Unix timestamp, a time in Splunk's default
+// %m/%d/%Y:%H:%M:%S format, or relative time such as "-24h@h" or
+// "-1d@d+9h": offsets, then optionally "@" and a unit to snap down to, then
+// more offsets.
+//
+// Weekday snaps "@w0" to "@w6" go back to the most recent Sunday to Saturday
+// ("@w7" is Sunday too); "@w" snaps to Sunday, as in Splunk. An empty
+// earliest means the start of time and an empty latest means now.
+func (p *Parser) parseSplunk(timeStr string, now time.Time, isLatest bool) (time.Time, error) {
+	timeStr = strings.ToLower(strings.Trim(strings.TrimSpace(timeStr), `"`))
+
+	switch timeStr {
+	case "":
+		if isLatest {
+			return now, nil
+		}
+
+		return time.Time{}, nil
+	case "now", "now()":
+		return now, nil
+	}
+
+	if isDigit(timeStr[0]) {
+		if t, ok := parseDecimalTimestamp(timeStr, time.Second); ok {
+			return p.localize(t), nil
+		}
+	}
+
+	if t, err := time.ParseInLocation(splunkTimeFormat, timeStr, p.dateLocation()); err == nil {
+		return p.localize(t), nil
+	}
+
+	t, ok := parseSplunkRelative(timeStr, now)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: %q is not a Splunk time modifier", ErrInvalidTimeFormat, timeStr)
+	}
+
+	return t, nil
+}
+
+// parseSplunkRelative evaluates a relative time modifier such as "-1d@d+9h".
+func parseSplunkRelative(s string, now time.Time) (time.Time, bool) {
+	t, rest, ok := applySplunkOffsets(s, now)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	if snap, ok := strings.CutPrefix(rest, "@"); ok {
+		name, tail := cutSplunkSnapUnit(snap)
+
+		t, ok = snapSplunk(t, name)
+		if !ok {
+			return time.Time{}, false
+		}
+
+		t, rest, ok = applySplunkOffsets(tail, t)
+		if !ok {
+			return time.Time{}, false
+		}
+	} else if rest == s {
+		return time.Time{}, false
+	}
+
+	return t, rest == ""
+}
+
+// applySplunkOffsets applies the leading "+<n><unit>" and "-<n><unit>"
+// offsets of s to t and returns the rest of s. The amount defaults to 1.
+func applySplunkOffsets(s string, t time.Time) (time.Time, string, bool) {
+	for s != "" && (s[0] == '+' || s[0] == '-') {
+		sign := 1
+		if s[0] == '-' {
+			sign = -1
+		}
+
+		end := 1
+		for end < len(s) && isDigit(s[end]) {
+			end++
+		}
+
+		num := 1
+
+		if end > 1 {
+			var err error
+
+			num, err = strconv.Atoi(s[1:end])
+			if err != nil {
+				return time.Time{}, "", false
+			}
+		}
+
+		name, rest := cutWord(s[end:])
+
+		unit, ok := lookupSplunkUnit(name)
+		if !ok {
+			return time.Time{}, "", false
+		}
+
+		t = addUnits(t, sign*num, unit)
+		s = rest
+	}
+
+	return t, s, true
+}
+
+// cutSplunkSnapUnit splits a snap unit, including weekday snaps such as
+// "w1", from the offsets that follow it.
+func cutSplunkSnapUnit(s string) (string, string) {
+	name, rest := cutWord(s)
+	if (name == "w" || name == "week") && rest != "" && isDigit(rest[0]) {
+		return name + rest[:1], rest[1:]
+	}
+
+	return name, rest
+}
+
+// snapSplunk snaps t down to the start of the named unit, or back to the
+// most recent given weekday for "w0" to "w7".
+func snapSplunk(t time.Time, name string) (time.Time, bool) {
+	if unit, ok := lookupSplunkUnit(name); ok {
+		return startOfUnit(t, unit, time.Sunday), true
+	}
+
+	digit, ok := strings.CutPrefix(name, "w")
+	if !ok {
+		digit, ok = strings.CutPrefix(name, "week")
+	}
+
+	if !ok || len(digit) != 1 || digit[0] < '0' || digit[0] > '7' {
+		return time.Time{}, false
+	}
+
+	weekday := time.Weekday(int(digit[0]-'0') % daysPerWeek)
+
+	return startOfUnit(t, unitWeek, weekday), true
+}
+
+// parseSplunkPair parses "earliest=<modifier> latest=<modifier>", in either
+// order and with either side optional, into a Range.
+func (p *Parser) parseSplunkPair(timeRange string, now time.Time) (Range, bool, error) {
+	var earliest, latest string
+
+	fields := strings.Fields(timeRange)
+
+	for _, field := range fields {
+		key, value, found := strings.Cut(field, "=")
+		if !found {
+			return Range{}, false, nil
+		}
+
+		switch strings.ToLower(key) {
+		case "earliest", "earliest_time":
+			earliest = value
+		case "latest", "latest_time":
+			latest = value
+		default:
+			return Range{}, true, fmt.Errorf("%w: unknown Splunk time argument %q", ErrInvalidTimeRange, key)
+		}
+	}
+
+	if len(fields) == 0 {
+		return Range{}, false, nil
+	}
+
+	r, err := p.parseBoundsAt(earliest, latest, now)
+
+	return r, true, err
+}
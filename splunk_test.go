@@ -0,0 +1,109 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime_Splunk(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	parser := NewParser(WithDialect(SplunkDialect))
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "now", expected: now},
+		{input: "-24h@h", expected: time.Date(2025, 12, 9, 15, 0, 0, 0, time.UTC)},
+		{input: "@d", expected: midnight(2025, 12, 10)},
+		{input: "-1d@d+9h", expected: time.Date(2025, 12, 9, 9, 0, 0, 0, time.UTC)},
+		{input: "-1d@d+9h+30m", expected: time.Date(2025, 12, 9, 9, 30, 0, 0, time.UTC)},
+		{input: "-2h", expected: now.Add(-2 * time.Hour)},
+		{input: "+3d", expected: now.AddDate(0, 0, 3)},
+		{input: "-d", expected: now.AddDate(0, 0, -1)},
+		{input: "-1d-2h", expected: now.AddDate(0, 0, -1).Add(-2 * time.Hour)},
+		{input: "-30minutes@m", expected: time.Date(2025, 12, 10, 15, 0, 0, 0, time.UTC)},
+		{input: "@w0", expected: midnight(2025, 12, 7)},
+		{input: "@w1", expected: midnight(2025, 12, 8)},
+		{input: "@w3", expected: midnight(2025, 12, 10)},
+		{input: "@w4", expected: midnight(2025, 12, 4)},
+		{input: "@w7", expected: midnight(2025, 12, 7)},
+		{input: "@w", expected: midnight(2025, 12, 7)},
+		{input: "-7d@w1", expected: midnight(2025, 12, 1)},
+		{input: "@mon", expected: midnight(2025, 12, 1)},
+		{input: "-1mon@mon", expected: midnight(2025, 11, 1)},
+		{input: "@q", expected: midnight(2025, 10, 1)},
+		{input: "-1y@y-1s", expected: time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC)},
+		{input: "1416434697", expected: time.Unix(1416434697, 0)},
+		{input: "1416434697.123", expected: time.Unix(1416434697, 123000000)},
+		{input: "0", expected: time.Unix(0, 0)},
+		{input: "12/10/2025:09:00:00", expected: time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC)},
+		{input: "-24H@H", expected: time.Date(2025, 12, 9, 15, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := parser.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+
+	invalid := []string{"-1x", "@x", "@w8", "-1d@", "yesterday", "-1d@d garbage", "1d", "@d@h", "1e5", "1416434697."}
+
+	for _, input := range invalid {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parser.ParseTime(input, now, time.Time{})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+}
+
+func TestParseRange_SplunkPairs(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	parser := NewParser(WithDialect(SplunkDialect), WithClock(fixedTime))
+
+	tests := []struct {
+		input string
+		start time.Time
+		end   time.Time
+	}{
+		{input: "earliest=-7d@d latest=@d", start: midnight(2025, 12, 3), end: midnight(2025, 12, 10)},
+		{input: "latest=@d earliest=-7d@d", start: midnight(2025, 12, 3), end: midnight(2025, 12, 10)},
+		{input: `earliest="-24h@h"`, start: time.Date(2025, 12, 9, 15, 0, 0, 0, time.UTC), end: fixedTime()},
+		{input: "latest=-1h", start: time.Time{}, end: fixedTime().Add(-time.Hour)},
+		{input: "earliest=@w1 latest=now", start: midnight(2025, 12, 8), end: fixedTime()},
+		{input: "-1d@d", start: midnight(2025, 12, 9), end: midnight(2025, 12, 9)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := parser.ParseRange(tt.input)
+			require.NoError(t, err)
+			assert.True(t, tt.start.Equal(r.Start), "expected start %v, got %v", tt.start, r.Start)
+			assert.True(t, tt.end.Equal(r.End), "expected end %v, got %v", tt.end, r.End)
+		})
+	}
+
+	t.Run("bounds", func(t *testing.T) {
+		r, err := parser.ParseBounds("-1d@d", "@d")
+		require.NoError(t, err)
+		assert.Equal(t, Range{Start: midnight(2025, 12, 9), End: midnight(2025, 12, 10)}, r)
+	})
+
+	t.Run("unknown argument", func(t *testing.T) {
+		_, err := parser.ParseRange("earliest=-1d span=1h")
+		assert.True(t, errors.Is(err, ErrInvalidTimeRange))
+	})
+
+	t.Run("latest before earliest", func(t *testing.T) {
+		_, err := parser.ParseRange("earliest=@d latest=-1d@d")
+		assert.True(t, errors.Is(err, ErrEndBeforeStart))
+	})
+}
@@ -0,0 +1,297 @@
+package friendlytime
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	// systemd's month and year lengths in time spans: 30.44 and 365.25 days.
+	systemdMonth = 2629800 * time.Second
+	systemdYear  = 31557600 * time.Second
+
+	// Timestamps: "Fri" or "Friday", then a date and a time.
+	systemdWeekdayAbbr = 3
+	systemdDateTime    = 2 // fields of a timestamp with both date and time
+)
+
+// systemdUnit is a time unit of systemd time spans.
+type systemdUnit struct {
+	names  []string
+	length time.Duration
+}
+
+// getSystemdUnits returns the time units of systemd time spans. Unit names
+// are case sensitive: "M" is months and "m" is minutes.
+func getSystemdUnits() []systemdUnit {
+	return []systemdUnit{
+		{names: []string{"nsec", "ns"}, length: time.Nanosecond},
+		{names: []string{"usec", "us", "µs", "μs"}, length: time.Microsecond},
+		{names: []string{"msec", "ms"}, length: time.Millisecond},
+		{names: []string{"seconds", "second", "sec", "s"}, length: time.Second},
+		{names: []string{"minutes", "minute", "min", "m"}, length: time.Minute},
+		{names: []string{"hours", "hour", "hr", "h"}, length: time.Hour},
+		{names: []string{"days", "day", "d"}, length: hoursPerDay * time.Hour},
+		{names: []string{"weeks", "week", "w"}, length: daysPerWeek * hoursPerDay * time.Hour},
+		{names: []string{"months", "month", "M"}, length: systemdMonth},
+		{names: []string{"years", "year", "y"}, length: systemdYear},
+	}
+}
+
+// lookupSystemdUnit finds the systemd time unit with the given name.
+func lookupSystemdUnit(name string) (time.Duration, bool) {
+	for _, unit := range getSystemdUnits() {
+		for _, unitName := range unit.names {
+			if unitName == name {
+				return unit.length, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// parseSystemd parses a time the way systemd.time(7) and journalctl --since
+// do:
+//
+//   - "now", "today", "yesterday", "tomorrow" (the last three at 00:00:00)
+//     and "epoch"
+//   - "@" followed by seconds since the epoch, which may be fractional
+//   - time spans relative to now: "+3h30min", "-5min", "11min ago" and
+//     "2d left"
+//   - timestamps "[weekday] [YYYY-MM-DD] [HH:MM[:SS[.fraction]]] [zone]"
+//
+// In timestamps, an omitted date is today, an omitted time is 00:00:00 and
+// omitted seconds are zero. Two-digit years 69-99 are in the 1900s and
+// 00-68 in the 2000s. A weekday must match the date, and a "T" may separate
+// date and time. The zone is "UTC", an IANA name, or "Z" or a numeric
+// offset attached to the time; without one the time is in now's location.
+//
+// An empty string is an open side of a range.
+func (p *Parser) parseSystemd(timeStr string, now time.Time) (time.Time, error) {
+	timeStr = strings.TrimSpace(timeStr)
+	lower := strings.ToLower(timeStr)
+
+	switch lower {
+	case "":
+		return time.Time{}, nil
+	case "now":
+		return now, nil
+	case "today":
+		return getMidnight(now), nil
+	case "yesterday":
+		return getMidnight(now).AddDate(0, 0, -1), nil
+	case "tomorrow":
+		return getMidnight(now).AddDate(0, 0, 1), nil
+	case "epoch":
+		return p.localize(time.Unix(0, 0)), nil
+	}
+
+	if rest, ok := strings.CutPrefix(timeStr, "@"); ok {
+		t, ok := parseDecimalTimestamp(strings.TrimSpace(rest), time.Second)
+		if !ok {
+			return time.Time{}, fmt.Errorf("%w: invalid systemd timestamp %q", ErrInvalidTimeFormat, timeStr)
+		}
+
+		return p.localize(t), nil
+	}
+
+	if spanStr, sign, ok := cutSystemdRelative(timeStr); ok {
+		d, err := parseSystemdSpan(spanStr)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %w", ErrInvalidTimeFormat, err)
+		}
+
+		return now.Add(time.Duration(sign) * d), nil
+	}
+
+	return parseSystemdTimestamp(timeStr, now)
+}
+
+// cutSystemdRelative splits a relative systemd time into its time span and
+// sign: "+" and "left" add to now, "-" and "ago" subtract from it.
+func cutSystemdRelative(timeStr string) (string, int, bool) {
+	switch {
+	case strings.HasPrefix(timeStr, "+"):
+		return timeStr[1:], 1, true
+	case strings.HasPrefix(timeStr, "-"):
+		return timeStr[1:], -1, true
+	}
+
+	if span, ok := strings.CutSuffix(timeStr, " ago"); ok {
+		return span, -1, true
+	}
+
+	if span, ok := strings.CutSuffix(timeStr, " left"); ok {
+		return span, 1, true
+	}
+
+	return "", 0, false
+}
+
+// parseSystemdSpan parses a systemd time span such as "3h 30min" or "1.5d".
+// A number without a unit is seconds.
+func parseSystemdSpan(spanStr string) (time.Duration, error) {
+	rest := strings.TrimSpace(spanStr)
+	if rest == "" {
+		return 0, fmt.Errorf("%w: empty time span", ErrInvalidDuration)
+	}
+
+	total := new(big.Rat)
+
+	for rest != "" {
+		numberStr, tail := cutNumber(rest)
+		if numberStr == "" {
+			return 0, fmt.Errorf("%w: missing amount in %q", ErrInvalidDuration, spanStr)
+		}
+
+		amount, ok := new(big.Rat).SetString(numberStr)
+		if !ok {
+			return 0, fmt.Errorf("%w: invalid amount %q", ErrInvalidDuration, numberStr)
+		}
+
+		name, tail := cutWord(strings.TrimLeft(tail, " "))
+
+		length := time.Second
+		if name != "" {
+			length, ok = lookupSystemdUnit(name)
+			if !ok {
+				return 0, fmt.Errorf("%w: unknown unit %q", ErrInvalidDuration, name)
+			}
+		}
+
+		total.Add(total, ratDuration(amount, length))
+		rest = strings.TrimLeft(tail, " ")
+	}
+
+	nanos := new(big.Int).Quo(total.Num(), total.Denom())
+	if !nanos.IsInt64() {
+		return 0, fmt.Errorf("%w: %q overflows", ErrInvalidDuration, spanStr)
+	}
+
+	return time.Duration(nanos.Int64()), nil
+}
+
+// parseSystemdTimestamp parses "[weekday] [date] [time] [zone]" with
+// systemd's rules for omitted components.
+func parseSystemdTimestamp(timeStr string, now time.Time) (time.Time, error) {
+	invalid := fmt.Errorf("%w: %q is not a systemd timestamp", ErrInvalidTimeFormat, timeStr)
+	fields, loc := cutSystemdZone(strings.Fields(timeStr), now.Location())
+
+	weekday, hasWeekday := parseSystemdWeekday(fields[0])
+	if hasWeekday {
+		fields = fields[1:]
+	}
+
+	dateStr, clockStr, ok := splitSystemdDateTime(fields)
+	if !ok {
+		return time.Time{}, invalid
+	}
+
+	hour, minute, sec, nsec := 0, 0, 0, 0
+
+	if clockStr != "" {
+		var zone *time.Location
+
+		hour, minute, sec, nsec, zone, ok = parseClock(clockStr)
+		if !ok {
+			return time.Time{}, invalid
+		}
+
+		if zone != nil {
+			loc = zone
+		}
+	}
+
+	year, month, day, err := resolveSystemdDate(dateStr, now.In(loc), timeStr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	t := time.Date(year, month, day, hour, minute, sec, nsec, loc)
+
+	if hasWeekday && t.Weekday() != weekday {
+		return time.Time{}, fmt.Errorf("%w: %q is not a %s", ErrInvalidWeekday, timeStr, weekday)
+	}
+
+	return t, nil
+}
+
+// cutSystemdZone removes a trailing "UTC" or IANA zone name from the fields
+// of a timestamp, returning the other fields and the location the timestamp
+// is in.
+func cutSystemdZone(fields []string, loc *time.Location) ([]string, *time.Location) {
+	if len(fields) <= 1 {
+		return fields, loc
+	}
+
+	last := fields[len(fields)-1]
+	if last == "UTC" {
+		return fields[:len(fields)-1], time.UTC
+	}
+
+	if zone, err := time.LoadLocation(last); err == nil && strings.Contains(last, "/") {
+		return fields[:len(fields)-1], zone
+	}
+
+	return fields, loc
+}
+
+// splitSystemdDateTime splits the fields of a timestamp after its weekday
+// into the date and the time, either of which may be omitted.
+func splitSystemdDateTime(fields []string) (string, string, bool) {
+	switch len(fields) {
+	case 1:
+		if before, after, found := strings.Cut(fields[0], "T"); found {
+			return before, after, true
+		}
+
+		if strings.Contains(fields[0], ":") {
+			return "", fields[0], true
+		}
+
+		return fields[0], "", true
+	case systemdDateTime:
+		return fields[0], fields[1], true
+	default:
+		return "", "", false
+	}
+}
+
+// resolveSystemdDate parses the date of a timestamp, or returns today's date
+// in today's location when it is omitted.
+func resolveSystemdDate(dateStr string, today time.Time, timeStr string) (int, time.Month, int, error) {
+	if dateStr == "" {
+		year, month, day := today.Date()
+
+		return year, month, day, nil
+	}
+
+	year, month, day, ok := parseNumericDate(dateStr)
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("%w: %q is not a systemd timestamp", ErrInvalidTimeFormat, timeStr)
+	}
+
+	if day > daysIn(year, month) {
+		return 0, 0, 0, fmt.Errorf("%w: %w: %q", ErrInvalidTimeFormat, ErrNonexistentDate, timeStr)
+	}
+
+	return year, month, day, nil
+}
+
+// parseSystemdWeekday parses a weekday name or its three-letter
+// abbreviation, in any case.
+func parseSystemdWeekday(s string) (time.Weekday, bool) {
+	s = strings.ToLower(s)
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if s == name || s == name[:systemdWeekdayAbbr] {
+			return day, true
+		}
+	}
+
+	return time.Sunday, false
+}
@@ -0,0 +1,100 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime_Systemd(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	parser := NewParser(WithDialect(SystemdDialect))
+	plus2 := time.FixedZone("", 2*60*60)
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "now", expected: now},
+		{input: "today", expected: midnight(2025, 12, 10)},
+		{input: "yesterday", expected: midnight(2025, 12, 9)},
+		{input: "tomorrow", expected: midnight(2025, 12, 11)},
+		{input: "epoch", expected: time.Unix(0, 0)},
+		{input: "-5min", expected: now.Add(-5 * time.Minute)},
+		{input: "+3h", expected: now.Add(3 * time.Hour)},
+		{input: "+3h30min", expected: now.Add(210 * time.Minute)},
+		{input: "-1d 2h", expected: now.Add(-26 * time.Hour)},
+		{input: "-1.5h", expected: now.Add(-90 * time.Minute)},
+		{input: "-30", expected: now.Add(-30 * time.Second)},
+		{input: "-1M", expected: now.Add(-2629800 * time.Second)},
+		{input: "-1y", expected: now.Add(-31557600 * time.Second)},
+		{input: "-500ms", expected: now.Add(-500 * time.Millisecond)},
+		{input: "-500ns", expected: now.Add(-500 * time.Nanosecond)},
+		{input: "+1us 500nsec", expected: now.Add(1500 * time.Nanosecond)},
+		{input: "11min ago", expected: now.Add(-11 * time.Minute)},
+		{input: "2 days left", expected: now.Add(48 * time.Hour)},
+		{input: "@1416434697", expected: time.Unix(1416434697, 0)},
+		{input: "@1416434697.25", expected: time.Unix(1416434697, 250000000)},
+		{input: "2012-11-23 11:12:13", expected: time.Date(2012, 11, 23, 11, 12, 13, 0, time.UTC)},
+		{input: "Fri 2012-11-23 11:12:13", expected: time.Date(2012, 11, 23, 11, 12, 13, 0, time.UTC)},
+		{input: "friday 2012-11-23 11:12", expected: time.Date(2012, 11, 23, 11, 12, 0, 0, time.UTC)},
+		{input: "2012-11-23 11:12:13.5", expected: time.Date(2012, 11, 23, 11, 12, 13, 500000000, time.UTC)},
+		{input: "2012-11-23", expected: midnight(2012, 11, 23)},
+		{input: "12-11-23", expected: midnight(2012, 11, 23)},
+		{input: "70-01-02", expected: midnight(1970, 1, 2)},
+		{input: "11:12:13", expected: time.Date(2025, 12, 10, 11, 12, 13, 0, time.UTC)},
+		{input: "11:12", expected: time.Date(2025, 12, 10, 11, 12, 0, 0, time.UTC)},
+		{input: "Wed 11:12", expected: time.Date(2025, 12, 10, 11, 12, 0, 0, time.UTC)},
+		{input: "2012-11-23T11:12:13Z", expected: time.Date(2012, 11, 23, 11, 12, 13, 0, time.UTC)},
+		{input: "2012-11-23T11:12+02:00", expected: time.Date(2012, 11, 23, 11, 12, 0, 0, plus2)},
+		{input: "2012-11-23 11:12:13 UTC", expected: time.Date(2012, 11, 23, 11, 12, 13, 0, time.UTC)},
+		{
+			input:    "2012-11-23 11:12:13 America/New_York",
+			expected: time.Date(2012, 11, 23, 16, 12, 13, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := parser.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+
+	for _, input := range []string{
+		"2012-13-01", "2012-02-30", "25:00", "11:60", "11:12:13.", "last week", "-5x", "-", "@x", "2012-11-23 11:12 junk",
+	} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parser.ParseTime(input, now, time.Time{})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+
+	t.Run("weekday must match the date", func(t *testing.T) {
+		_, err := parser.ParseTime("Mon 2012-11-23 11:12:13", now, time.Time{})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrInvalidWeekday))
+	})
+}
+
+func TestParseBounds_Systemd(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	parser := NewParser(WithDialect(SystemdDialect), WithClock(fixedTime))
+
+	r, err := parser.ParseBounds("yesterday", "today")
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: midnight(2025, 12, 9), End: midnight(2025, 12, 10)}, r)
+
+	r, err = parser.ParseBounds("-1h", "")
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: fixedTime().Add(-time.Hour)}, r)
+
+	_, err = parser.ParseBounds("today", "yesterday")
+	assert.True(t, errors.Is(err, ErrEndBeforeStart))
+}
@@ -3,67 +3,40 @@ package friendlytime
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 )
 
 const (
-	// Time conversion constants.
+	// Fixed unit lengths used for days, months and years.
 	hoursPerYear  = 8760 // 365 days * 24 hours
 	hoursPerMonth = 720  // 30 days * 24 hours
 	hoursPerDay   = 24
 
+	// Clock and calendar arithmetic.
+	minutesPerHour   = 60
+	secondsPerMinute = 60
+	secondsPerHour   = 3600
+	yearsPerCentury  = 100
+
 	// Timestamp boundaries.
 	partsCountInRange          = 2
-	timestampMillisecondBorder = 9999999999 // Timestamps > this are treated as milliseconds
+	timestampMillisecondBorder = 9999999999       // Timestamps > this are treated as milliseconds
+	timestampMicrosecondBorder = 9999999999999    // Timestamps > this are treated as microseconds
+	timestampNanosecondBorder  = 9999999999999999 // Timestamps > this are treated as nanoseconds
 	millisecondsPerSecond      = 1000
 	nanosecondsPerMillisecond  = 1000000
 )
 
-// unitConversion defines time unit conversions to hours.
-type unitConversion struct {
-	patterns []string
-	toHours  int
-}
-
-// simpleUnit defines simple time unit conversions.
-type simpleUnit struct {
-	patterns []string
-	to       string
-}
-
-// getTimeUnitsToHours returns unit conversions that need to be converted to hours.
-func getTimeUnitsToHours() []unitConversion {
-	return []unitConversion{
-		{patterns: []string{"years", "year"}, toHours: hoursPerYear},
-		{patterns: []string{"months", "month"}, toHours: hoursPerMonth},
-		{patterns: []string{"days", "day"}, toHours: hoursPerDay},
-	}
-}
-
-// getSimpleTimeUnits returns simple time unit conversions.
-func getSimpleTimeUnits() []simpleUnit {
-	return []simpleUnit{
-		{patterns: []string{"seconds", "second", "sec"}, to: "s"},
-		{patterns: []string{"minutes", "minute", "min"}, to: "m"},
-		{patterns: []string{"hours", "hour"}, to: "h"},
-	}
-}
-
-// getReservedKeywords returns keywords that should not be converted.
-func getReservedKeywords() []string {
-	return []string{
-		"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday",
-		"yesterday", "last week", "last month", "last year",
-	}
-}
-
 // ParseTimeRange parses human-readable time range to UNIX timestamps.
 // It returns start and end timestamps in seconds since Unix epoch.
 //
 // The function supports various formats:
 //   - Single time values: "1h" (start and end are the same)
+//   - Periods: "Q3 2025", "FY2026" (start and end of the period)
 //   - Time ranges with "/": "1h/30m" (from 1 hour ago to 30 minutes ago)
 //   - Empty start or end: "/now" or "yesterday/" (empty means zero or now)
 //   - Relative offsets: "1h/+30m" (from 1 hour ago, plus 30 minutes from that)
@@ -79,57 +52,102 @@ func getReservedKeywords() []string {
 //   - "/1416434697" -> from zero time till the specified timestamp
 //   - "40 minutes ago/35 min ago" -> from 40 minutes ago till 35 minutes ago
 //   - "last monday/yesterday" -> from last monday 00:00:00 till yesterday 00:00:00
+//   - "2025-12-10T00:00Z/P1D" -> ISO 8601 interval: the day of December 10, 2025
 //
 // Returns:
 //   - start: Unix timestamp in seconds for the start of the range
 //   - end: Unix timestamp in seconds for the end of the range
 //   - error: An error if parsing fails or if end time is before start time
+//
+// ParseTimeRange uses the default options; see Parser for configurable parsing.
 func ParseTimeRange(timeRange string) (int64, int64, error) {
+	return defaultParser.ParseTimeRange(timeRange)
+}
+
+// ParseTimeRange parses a time range like the package-level ParseTimeRange,
+// using the parser's options.
+func (p *Parser) ParseTimeRange(timeRange string) (int64, int64, error) {
 	if timeRange == "" {
 		return 0, 0, nil
 	}
 
-	now := time.Now()
-
-	if !strings.Contains(timeRange, "/") {
-		return parseSingleTime(timeRange, now)
+	r, err := p.ParseRange(timeRange)
+	if err != nil {
+		return 0, 0, err
 	}
 
-	return parseTimeRangeParts(timeRange, now)
+	return r.Start.Unix(), r.End.Unix(), nil
 }
 
-// parseSingleTime parses a single time value (no range).
-func parseSingleTime(timeRange string, now time.Time) (int64, int64, error) {
-	startTime, err := ParseTime(timeRange, now, time.Time{})
+// parseSingleTime parses a single time value (no range). A bare ISO 8601
+// duration is an interval ending now.
+func (p *Parser) parseSingleTime(timeRange string, now time.Time) (Range, error) {
+	if duration, ok, err := tryParseISOIntervalDuration(timeRange); ok {
+		if err != nil {
+			return Range{}, err
+		}
+
+		return Range{Start: duration.addTo(now, -1), End: now}, nil
+	}
+
+	if start, end, ok, err := p.tryParsePeriod(timeRange, now); ok {
+		return Range{Start: start, End: end}, err
+	}
+
+	startTime, err := p.ParseTime(timeRange, now, time.Time{})
 	if err != nil {
-		return 0, 0, err
+		return Range{}, err
 	}
 
-	return startTime.Unix(), startTime.Unix(), nil
+	return Range{Start: startTime, End: startTime}, nil
 }
 
 // parseTimeRangeParts parses a time range with "/" separator.
-func parseTimeRangeParts(timeRange string, now time.Time) (int64, int64, error) {
+func (p *Parser) parseTimeRangeParts(timeRange string, now time.Time) (Range, error) {
 	parts := strings.Split(timeRange, "/")
 	if len(parts) != partsCountInRange {
-		return 0, 0, ErrInvalidTimeRange
+		return Range{}, ErrInvalidTimeRange
+	}
+
+	return p.parseRangeSides(parts[0], parts[1], now)
+}
+
+// parseRangeSides parses the start and end of a range: an ISO 8601 interval
+// with a duration on one side, or two bounds.
+func (p *Parser) parseRangeSides(startStr, endStr string, now time.Time) (Range, error) {
+	if r, ok, err := p.tryParseISOInterval(startStr, endStr, now); ok {
+		return r, err
 	}
 
-	startTime, err := ParseTime(parts[0], now, time.Time{})
+	startTime, err := p.parseBound(startStr, now, time.Time{}, false)
 	if err != nil {
-		return 0, 0, fmt.Errorf("%w: %w", ErrInvalidStartTime, err)
+		return Range{}, fmt.Errorf("%w: %w", ErrInvalidStartTime, err)
 	}
 
-	endTime, err := ParseTime(parts[1], now, startTime)
+	endTime, err := p.parseBound(endStr, now, startTime, true)
 	if err != nil {
-		return 0, 0, fmt.Errorf("%w: %w", ErrInvalidEndTime, err)
+		return Range{}, fmt.Errorf("%w: %w", ErrInvalidEndTime, err)
 	}
 
 	if !endTime.IsZero() && !startTime.IsZero() && endTime.Before(startTime) {
-		return 0, 0, ErrEndBeforeStart
+		return Range{}, ErrEndBeforeStart
+	}
+
+	return Range{Start: startTime, End: endTime}, nil
+}
+
+// parseBound parses one side of a range. Period expressions resolve to their
+// start, or to their end when isEnd is set.
+func (p *Parser) parseBound(timeStr string, now, startTime time.Time, isEnd bool) (time.Time, error) {
+	if start, end, ok, err := p.tryParsePeriod(timeStr, now); ok {
+		if isEnd {
+			return end, err
+		}
+
+		return start, err
 	}
 
-	return startTime.Unix(), endTime.Unix(), nil
+	return p.ParseTime(timeStr, now, startTime)
 }
 
 // ParseTime parses a human-readable time string to a time.Time value.
@@ -137,11 +155,17 @@ func parseTimeRangeParts(timeRange string, now time.Time) (int64, int64, error)
 // The function accepts various formats including:
 //   - Durations: "1h", "30m", "45s" (relative to now)
 //   - Custom units: "5 days ago", "2 months", "1 year"
+//   - Compound durations: "1 day 2 hours ago", "1 year, 6 months and 3 days"
+//   - Future durations: "in 2 hours", "1h30m from now"
 //   - Weekdays: "last monday", "yesterday"
+//   - Weekdays of a month: "first monday of next month", "last friday of december"
 //   - Time of day: "15:30", "09:00"
-//   - Dates: "2006-01-02", "06-01-02 15:04:05"
+//   - Dates: "2006-01-02", "06-01-02 15:04:05", "2025-12-10T15:00:00Z"
 //   - Unix timestamps: "1416434697"
 //   - Relative offsets: "+30m" (relative to startTime), "-15m" (relative to now)
+//   - Date math: "2025-12-10+3d-2h", "yesterday+9h", "now-1d"
+//   - Periods: "Q3 2025", "H1", "FY2026", "last quarter" (resolve to the period's start)
+//   - Offsets from an expression: "2 hours before 2025-12-10 15:00", "3 days after last monday"
 //
 // Parameters:
 //   - timeStr: The time string to parse
@@ -157,7 +181,21 @@ func parseTimeRangeParts(timeRange string, now time.Time) (int64, int64, error)
 //   - "+30m" -> 30 minutes after startTime
 //
 // Returns a time.Time value or an error if the format is not recognized.
+//
+// ParseTime uses the default options; see Parser for configurable parsing.
 func ParseTime(timeStr string, now, startTime time.Time) (time.Time, error) {
+	return defaultParser.ParseTime(timeStr, now, startTime)
+}
+
+// ParseTime parses a time string like the package-level ParseTime, using the
+// parser's options.
+func (p *Parser) ParseTime(timeStr string, now, startTime time.Time) (time.Time, error) {
+	now = p.localize(now)
+
+	if p.dialect != DefaultDialect {
+		return p.parseDialectTime(timeStr, now, startTime)
+	}
+
 	if timeStr == "" {
 		return handleEmptyTime(startTime, now), nil
 	}
@@ -165,17 +203,37 @@ func ParseTime(timeStr string, now, startTime time.Time) (time.Time, error) {
 	timeStr = strings.TrimSpace(timeStr)
 
 	// Try to parse as Unix timestamp
-	if t, ok := tryParseUnixTimestamp(timeStr); ok {
+	if t, ok := p.tryParseUnixTimestamp(timeStr); ok {
 		return t, nil
 	}
 
+	// Try periods such as "Q3 2025", resolving to their start
+	if start, _, ok, err := p.tryParsePeriod(timeStr, now); ok {
+		return start, err
+	}
+
+	// Try date math such as "2025-12-10+3d-2h"
+	if t, ok, err := p.tryParseDateMath(timeStr, now); ok {
+		return t, err
+	}
+
+	// Try offsets from another expression such as "3 days after last monday"
+	if t, ok, err := p.tryParseRelativeTo(timeStr, now, startTime); ok {
+		return t, err
+	}
+
+	// Try calendar expressions such as "first monday of next month"
+	if t, ok, err := p.tryParseCalendarFormats(timeStr, now); ok {
+		return t, err
+	}
+
 	// Try relative time formats
-	if t, ok, err := tryParseRelativeFormats(timeStr, now, startTime); ok || err != nil {
+	if t, ok, err := p.tryParseRelativeFormats(timeStr, now, startTime); ok || err != nil {
 		return t, err
 	}
 
 	// Try duration and date formats
-	return tryParseDateFormats(timeStr, now)
+	return p.tryParseDateFormats(timeStr, now)
 }
 
 // handleEmptyTime returns appropriate time for empty input.
@@ -187,38 +245,97 @@ func handleEmptyTime(startTime, now time.Time) time.Time {
 	return now
 }
 
-// tryParseUnixTimestamp attempts to parse as Unix timestamp.
-func tryParseUnixTimestamp(timeStr string) (time.Time, bool) {
-	unixTime, err := strconv.ParseInt(timeStr, 10, 64)
+// getTimestampUnits returns the unit suffixes of Unix timestamps.
+func getTimestampUnits() map[string]time.Duration {
+	return map[string]time.Duration{
+		"s":  time.Second,
+		"ms": time.Millisecond,
+		"us": time.Microsecond,
+		"µs": time.Microsecond,
+		"μs": time.Microsecond,
+		"ns": time.Nanosecond,
+	}
+}
+
+// tryParseUnixTimestamp attempts to parse as Unix timestamp: a number,
+// optionally fractional and optionally marked with a leading "@", such as
+// "1416434697", "1416434697.123" or "@1416434697".
+//
+// Without a unit, the unit is guessed from the magnitude; see
+// parseUnixTimestamp. An explicit unit suffix (s, ms, us or ns) needs the
+// "@" marker, as in "@1416434697123ms", because "30s" on its own is a
+// duration. In strict mode only numbers with a unit are timestamps.
+func (p *Parser) tryParseUnixTimestamp(timeStr string) (time.Time, bool) {
+	numberStr, marked := strings.CutPrefix(timeStr, "@")
+	numberStr, unitName := cutTimestampUnit(numberStr)
+
+	if !isDecimal(numberStr) {
+		return time.Time{}, false
+	}
+
+	if unitName != "" {
+		unit, ok := getTimestampUnits()[unitName]
+		if !ok || !marked {
+			return time.Time{}, false
+		}
+
+		return parseDecimalTimestamp(numberStr, unit)
+	}
+
+	if p.strictTimestamps {
+		return time.Time{}, false
+	}
+
+	intPart, _, hasFraction := strings.Cut(numberStr, ".")
+
+	unixTime, err := strconv.ParseInt(intPart, 10, 64)
 	if err != nil {
 		return time.Time{}, false
 	}
 
-	t := parseUnixTimestamp(unixTime)
+	if !hasFraction {
+		return parseUnixTimestamp(unixTime), true
+	}
+
+	return parseDecimalTimestamp(numberStr, timestampUnit(unixTime))
+}
+
+// cutTimestampUnit splits a timestamp into its number and its unit suffix, if any.
+func cutTimestampUnit(s string) (string, string) {
+	number := strings.TrimRightFunc(s, unicode.IsLetter)
 
-	return t, true
+	return number, s[len(number):]
 }
 
 // tryParseRelativeFormats attempts to parse relative time formats.
-func tryParseRelativeFormats(timeStr string, now, startTime time.Time) (time.Time, bool, error) {
+func (p *Parser) tryParseRelativeFormats(timeStr string, now, startTime time.Time) (time.Time, bool, error) {
 	lowerTimeStr := strings.ToLower(timeStr)
 
+	if lowerTimeStr == "now" {
+		return now, true, nil
+	}
+
 	// Check for "last" keywords and "yesterday"
 	if strings.HasPrefix(lowerTimeStr, "last ") || strings.HasPrefix(lowerTimeStr, "yesterday") {
-		t, err := parseRelativeTime(lowerTimeStr, now, startTime, false)
+		t, err := p.parseRelativeTime(lowerTimeStr, now, startTime, false)
 
 		return t, true, err
 	}
 
 	// Handle "N units ago" format
-	if strings.Contains(timeStr, " ago") {
-		t, err := parseAgoFormat(timeStr, now, startTime)
+	if strings.HasSuffix(lowerTimeStr, " ago") {
+		t, err := p.parseAgoFormat(lowerTimeStr, now, startTime)
+
+		return t, true, err
+	}
 
+	// Handle "in N units" format
+	if t, ok, err := p.tryParseFutureFormat(lowerTimeStr, now); ok {
 		return t, true, err
 	}
 
 	// Handle + and - prefixes
-	if t, ok, err := tryParsePrefixedTime(timeStr, now, startTime); ok {
+	if t, ok, err := p.tryParsePrefixedTime(timeStr, now, startTime); ok {
 		return t, true, err
 	}
 
@@ -226,30 +343,37 @@ func tryParseRelativeFormats(timeStr string, now, startTime time.Time) (time.Tim
 }
 
 // parseAgoFormat handles "N units ago" format.
-func parseAgoFormat(timeStr string, now, startTime time.Time) (time.Time, error) {
-	cleanStr := strings.Replace(timeStr, " ago", "", 1)
+func (p *Parser) parseAgoFormat(timeStr string, now, startTime time.Time) (time.Time, error) {
+	cleanStr := strings.TrimSuffix(timeStr, " ago")
 
-	cleanStr = convertCustomUnits(cleanStr)
+	return p.parseRelativeTime(cleanStr, now, startTime, false)
+}
+
+// tryParseFutureFormat handles "in N units" format.
+func (p *Parser) tryParseFutureFormat(timeStr string, now time.Time) (time.Time, bool, error) {
+	durationStr, ok := strings.CutPrefix(timeStr, "in ")
+	if !ok {
+		return time.Time{}, false, nil
+	}
 
-	cleanStr = strings.Join(strings.Fields(cleanStr), "")
+	duration, err := p.parseSpan(durationStr)
+	if err != nil {
+		return time.Time{}, true, fmt.Errorf("%w: %w", ErrInvalidTimeFormat, err)
+	}
 
-	return parseRelativeTime(cleanStr, now, startTime, false)
+	return duration.addTo(now, 1), true, nil
 }
 
 // tryParsePrefixedTime handles + and - prefixed times.
-func tryParsePrefixedTime(timeStr string, now, startTime time.Time) (time.Time, bool, error) {
+func (p *Parser) tryParsePrefixedTime(timeStr string, now, startTime time.Time) (time.Time, bool, error) {
 	if strings.HasPrefix(timeStr, "+") {
-		converted := convertCustomUnits(timeStr[1:])
-
-		t, err := parseRelativeTime(converted, now, startTime, true)
+		t, err := p.parseRelativeTime(timeStr[1:], now, startTime, true)
 
 		return t, true, err
 	}
 
 	if strings.HasPrefix(timeStr, "-") {
-		converted := convertCustomUnits(timeStr[1:])
-
-		t, err := parseRelativeTime(converted, now, startTime, false)
+		t, err := p.parseRelativeTime(timeStr[1:], now, startTime, false)
 
 		return t, true, err
 	}
@@ -258,16 +382,14 @@ func tryParsePrefixedTime(timeStr string, now, startTime time.Time) (time.Time,
 }
 
 // tryParseDateFormats attempts to parse various date and time formats.
-func tryParseDateFormats(timeStr string, now time.Time) (time.Time, error) {
-	converted := convertCustomUnits(timeStr)
-
+func (p *Parser) tryParseDateFormats(timeStr string, now time.Time) (time.Time, error) {
 	// Try duration
-	if duration, err := time.ParseDuration(converted); err == nil {
-		return now.Add(-duration), nil
+	if duration, err := p.parseSpan(timeStr); err == nil {
+		return duration.addTo(now, -1), nil
 	}
 
 	// Try time of day
-	if t, ok := tryParseTimeOfDay(converted, now); ok {
+	if t, ok := p.tryParseTimeOfDay(timeStr, now); ok {
 		return t, nil
 	}
 
@@ -275,13 +397,18 @@ func tryParseDateFormats(timeStr string, now time.Time) (time.Time, error) {
 	formats := []string{
 		"06-01-02",
 		"2006-01-02",
+		"2006-01-02 15:04",
 		"06-01-02 15:04:05",
 		"2006-01-02 15:04:05",
 		"Mon, 02 Jan 2006 15:04:05",
+		time.RFC3339,
+		"2006-01-02T15:04Z07:00",
+		"2006-01-02T15:04:05",
+		"2006-01-02T15:04",
 	}
 
 	for _, format := range formats {
-		if t, err := time.Parse(format, converted); err == nil {
+		if t, err := time.ParseInLocation(format, timeStr, p.dateLocation()); err == nil {
 			return t, nil
 		}
 	}
@@ -289,215 +416,20 @@ func tryParseDateFormats(timeStr string, now time.Time) (time.Time, error) {
 	return time.Time{}, ErrInvalidTimeFormat
 }
 
-// tryParseTimeOfDay attempts to parse time of day format (HH:MM).
-func tryParseTimeOfDay(timeStr string, now time.Time) (time.Time, bool) {
+// tryParseTimeOfDay attempts to parse time of day format (HH:MM). The time
+// is today's unless the parser prefers another day.
+func (p *Parser) tryParseTimeOfDay(timeStr string, now time.Time) (time.Time, bool) {
 	t, err := time.Parse("15:04", timeStr)
 	if err != nil {
 		return time.Time{}, false
 	}
 
-	return time.Date(
-		now.Year(),
-		now.Month(),
-		now.Day(),
-		t.Hour(),
-		t.Minute(),
-		0,
-		0,
-		now.Location(),
-	), true
-}
-
-func convertCustomUnits(timeStr string) string {
-	if isReservedKeyword(timeStr) {
-		return timeStr
-	}
-
-	if result := tryConvertToHours(timeStr); result != "" {
-		return result
-	}
-
-	if result := tryConvertShortForms(timeStr); result != "" {
-		return result
-	}
-
-	if result := tryConvertSimpleUnits(timeStr); result != "" {
-		return result
-	}
-
-	return timeStr
+	return p.preferred(func(n int) time.Time {
+		return time.Date(now.Year(), now.Month(), now.Day()+n, t.Hour(), t.Minute(), 0, 0, now.Location())
+	}, now), true
 }
 
-// isReservedKeyword checks if the string contains reserved keywords.
-func isReservedKeyword(timeStr string) bool {
-	lowerTimeStr := strings.ToLower(timeStr)
-	for _, keyword := range getReservedKeywords() {
-		if strings.Contains(lowerTimeStr, keyword) {
-			return true
-		}
-	}
-
-	return false
-}
-
-// tryConvertToHours converts time units to hours.
-func tryConvertToHours(timeStr string) string {
-	for _, conv := range getTimeUnitsToHours() {
-		for _, pattern := range conv.patterns {
-			if converted, ok := tryConvertUnit(timeStr, pattern, conv.toHours); ok {
-				return converted
-			}
-		}
-	}
-
-	return ""
-}
-
-// tryConvertShortForms handles short form conversions (d, y).
-func tryConvertShortForms(timeStr string) string {
-	if result := tryConvertShortForm(timeStr, "d", hoursPerDay, "day"); result != "" {
-		return result
-	}
-
-	if result := tryConvertShortForm(timeStr, "y", hoursPerYear, "year"); result != "" {
-		return result
-	}
-
-	return ""
-}
-
-// tryConvertSimpleUnits converts simple time units.
-func tryConvertSimpleUnits(timeStr string) string {
-	for _, conv := range getSimpleTimeUnits() {
-		for _, pattern := range conv.patterns {
-			if converted, ok := tryConvertSimpleUnit(timeStr, pattern, conv.to); ok {
-				return converted
-			}
-		}
-	}
-
-	return ""
-}
-
-// tryConvertUnit attempts to convert a time string with a unit pattern to hours.
-func tryConvertUnit(timeStr, pattern string, toHours int) (string, bool) {
-	// Try "N pattern" format (e.g., "2 days")
-	if result, ok := tryConvertWithPattern(timeStr, " "+pattern, toHours); ok {
-		return result, true
-	}
-
-	// Try "Npattern" format (e.g., "2days")
-	if result, ok := tryConvertWithPattern(timeStr, pattern, toHours); ok {
-		return result, true
-	}
-
-	return "", false
-}
-
-// tryConvertWithPattern is a helper for tryConvertUnit.
-func tryConvertWithPattern(timeStr, pattern string, toHours int) (string, bool) {
-	idx := strings.Index(timeStr, pattern)
-	if idx <= 0 {
-		return "", false
-	}
-
-	prefix := timeStr[:idx]
-
-	var amount int
-	if n, _ := fmt.Sscanf(prefix, "%d", &amount); n != 1 || amount < 0 {
-		return "", false
-	}
-
-	endIdx := idx + len(pattern)
-	if endIdx < len(timeStr) && isLetter(rune(timeStr[endIdx])) {
-		return "", false
-	}
-
-	hours := amount * toHours
-
-	suffix := ""
-	if endIdx < len(timeStr) {
-		suffix = timeStr[endIdx:]
-	}
-
-	return fmt.Sprintf("%dh%s", hours, suffix), true
-}
-
-// tryConvertSimpleUnit attempts to convert a time string with a simple unit pattern.
-func tryConvertSimpleUnit(timeStr, pattern, to string) (string, bool) {
-	// Try "N pattern" format (e.g., "10 seconds")
-	if result, ok := tryConvertSimpleWithPattern(timeStr, " "+pattern, to); ok {
-		return result, true
-	}
-
-	// Try "Npattern" format (e.g., "10seconds")
-	if result, ok := tryConvertSimpleWithPattern(timeStr, pattern, to); ok {
-		return result, true
-	}
-
-	return "", false
-}
-
-// tryConvertSimpleWithPattern is a helper for tryConvertSimpleUnit.
-func tryConvertSimpleWithPattern(timeStr, pattern, to string) (string, bool) {
-	idx := strings.Index(timeStr, pattern)
-	if idx <= 0 {
-		return "", false
-	}
-
-	prefix := timeStr[:idx]
-
-	var amount int
-	if n, _ := fmt.Sscanf(prefix, "%d", &amount); n != 1 || amount < 0 {
-		return "", false
-	}
-
-	endIdx := idx + len(pattern)
-	if endIdx < len(timeStr) && isLetter(rune(timeStr[endIdx])) {
-		return "", false
-	}
-
-	suffix := ""
-	if endIdx < len(timeStr) {
-		suffix = timeStr[endIdx:]
-	}
-
-	return fmt.Sprintf("%d%s%s", amount, to, suffix), true
-}
-
-// isLetter checks if a rune is a letter.
-func isLetter(r rune) bool {
-	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
-}
-
-// tryConvertShortForm attempts to convert short form units (d, y) to hours.
-func tryConvertShortForm(timeStr, shortForm string, toHours int, longForm string) string {
-	if !strings.Contains(timeStr, shortForm) || strings.Contains(timeStr, longForm) {
-		return ""
-	}
-
-	// Try to extract "Nshortform" pattern (e.g., "5d", "1y")
-	var amount int
-
-	searchPattern := fmt.Sprintf("%%d%s", shortForm)
-	if n, _ := fmt.Sscanf(timeStr, searchPattern, &amount); n == 1 && amount >= 0 {
-		target := fmt.Sprintf("%d%s", amount, shortForm)
-
-		idx := strings.Index(timeStr, target)
-		if idx >= 0 {
-			endIdx := idx + len(target)
-			if endIdx >= len(timeStr) || !isLetter(rune(timeStr[endIdx])) {
-				hours := amount * toHours
-
-				return strings.Replace(timeStr, target, fmt.Sprintf("%dh", hours), 1)
-			}
-		}
-	}
-
-	return ""
-}
-
-func parseRelativeTime(
+func (p *Parser) parseRelativeTime(
 	durationStr string,
 	now, startTime time.Time,
 	isPositive bool,
@@ -518,23 +450,23 @@ func parseRelativeTime(
 		return getMidnight(now.AddDate(-1, 0, 0)), nil
 	default:
 		if strings.HasPrefix(durationStr, "last ") {
-			return parseLastWeekday(durationStr, now)
+			return p.parseLastWeekday(durationStr, now)
 		}
 
-		duration, err := time.ParseDuration(strings.ReplaceAll(durationStr, " ", ""))
+		duration, err := p.parseSpan(durationStr)
 		if err != nil {
-			return time.Time{}, err
+			return time.Time{}, fmt.Errorf("%w: %w", ErrInvalidTimeFormat, err)
 		}
 
 		if isPositive {
 			if startTime.IsZero() {
-				return now.Add(duration), nil
+				return duration.addTo(now, 1), nil
 			}
 
-			return startTime.Add(duration), nil
+			return duration.addTo(startTime, 1), nil
 		}
 
-		return now.Add(-duration), nil
+		return duration.addTo(now, -1), nil
 	}
 }
 
@@ -543,14 +475,29 @@ func getMidnight(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
 }
 
-func parseLastWeekday(durationStr string, now time.Time) (time.Time, error) {
+// parseLastWeekday resolves "last <weekday>" to the most recent such day
+// before today, and "last weekday" or "last business day" to the most recent
+// day before today that isn't a weekend day. With a week start configured,
+// "last <weekday>" is that day in the previous week instead.
+func (p *Parser) parseLastWeekday(durationStr string, now time.Time) (time.Time, error) {
 	weekdayStr := strings.TrimPrefix(durationStr, "last ")
 
+	switch weekdayStr {
+	case "weekday", "business day", "working day", "workday":
+		return p.lastWorkday(now)
+	}
+
 	weekday, err := parseWeekday(weekdayStr)
 	if err != nil {
 		return time.Time{}, err
 	}
 
+	if p.lastWeekdayPrevWeek {
+		daysIntoWeek := (int(weekday) - int(p.weekStart()) + daysPerWeek) % daysPerWeek
+
+		return p.startOfWeek(now).AddDate(0, 0, daysIntoWeek-daysPerWeek), nil
+	}
+
 	// Calculate the date for the last occurrence of the specified weekday
 	daysAgo := int(now.Weekday() - weekday)
 	if daysAgo <= 0 {
@@ -562,20 +509,76 @@ func parseLastWeekday(durationStr string, now time.Time) (time.Time, error) {
 	return getMidnight(lastWeekday), nil
 }
 
-// parseUnixTimestamp parses a Unix timestamp, handling both seconds and milliseconds.
+// parseUnixTimestamp parses a Unix timestamp, guessing its unit from its
+// magnitude; see timestampUnit.
 func parseUnixTimestamp(unixTime int64) time.Time {
-	// Detect if it's milliseconds (13 digits or more) vs seconds (10 digits or less)
-	// Timestamps > 9999999999 are either after year 2286 in seconds, or milliseconds
-	if unixTime > timestampMillisecondBorder {
-		// Treat as milliseconds
+	switch timestampUnit(unixTime) {
+	case time.Nanosecond:
+		return time.Unix(0, unixTime)
+	case time.Microsecond:
+		return time.UnixMicro(unixTime)
+	case time.Millisecond:
 		sec := unixTime / millisecondsPerSecond
 		nsec := (unixTime % millisecondsPerSecond) * nanosecondsPerMillisecond
 
 		return time.Unix(sec, nsec)
+	default:
+		// Treat as seconds (including negative timestamps for dates before 1970)
+		return time.Unix(unixTime, 0)
+	}
+}
+
+// timestampUnit guesses the unit of a Unix timestamp from its magnitude.
+// Each border is the largest value that is before the year 2286 in the
+// next larger unit, so seconds up to 9999999999 are seconds, and larger
+// values are milliseconds, then microseconds, then nanoseconds.
+func timestampUnit(unixTime int64) time.Duration {
+	switch {
+	case unixTime > timestampNanosecondBorder:
+		return time.Nanosecond
+	case unixTime > timestampMicrosecondBorder:
+		return time.Microsecond
+	case unixTime > timestampMillisecondBorder:
+		return time.Millisecond
+	default:
+		return time.Second
+	}
+}
+
+// parseDecimalTimestamp parses a Unix timestamp in the given unit, with an
+// optional sign and decimal fraction, such as "1416434697.123". Digits past
+// nanoseconds are truncated.
+func parseDecimalTimestamp(s string, unit time.Duration) (time.Time, bool) {
+	if !isDecimal(s) {
+		return time.Time{}, false
 	}
 
-	// Treat as seconds (including negative timestamps for dates before 1970)
-	return time.Unix(unixTime, 0)
+	value, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	nanos := ratDuration(value, unit)
+	whole := new(big.Int).Quo(nanos.Num(), nanos.Denom())
+
+	sec, nsec := new(big.Int).DivMod(whole, big.NewInt(int64(time.Second)), new(big.Int))
+	if !sec.IsInt64() {
+		return time.Time{}, false
+	}
+
+	return time.Unix(sec.Int64(), nsec.Int64()), true
+}
+
+// isDecimal reports whether s is a decimal number: an optional sign, digits,
+// and an optional fraction.
+func isDecimal(s string) bool {
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+
+	intPart, fraction, hasFraction := strings.Cut(s, ".")
+
+	return isAllDigits(intPart) && (!hasFraction || isAllDigits(fraction))
 }
 
 func parseWeekday(weekdayStr string) (time.Weekday, error) {
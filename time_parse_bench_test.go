@@ -176,44 +176,44 @@ func BenchmarkParseTimeRange_RelativeOffset(b *testing.B) {
 	}
 }
 
-// Benchmark for convertCustomUnits.
-func BenchmarkConvertCustomUnits_Seconds(b *testing.B) {
+// Benchmark for parseSpan.
+func BenchmarkParseSpan_Seconds(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		_ = convertCustomUnits("30 seconds")
+		_, _ = defaultParser.parseSpan("30 seconds")
 	}
 }
 
-func BenchmarkConvertCustomUnits_Days(b *testing.B) {
+func BenchmarkParseSpan_Days(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		_ = convertCustomUnits("5 days")
+		_, _ = defaultParser.parseSpan("5 days")
 	}
 }
 
-func BenchmarkConvertCustomUnits_Months(b *testing.B) {
+func BenchmarkParseSpan_Months(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		_ = convertCustomUnits("2 months")
+		_, _ = defaultParser.parseSpan("2 months")
 	}
 }
 
-func BenchmarkConvertCustomUnits_Years(b *testing.B) {
+func BenchmarkParseSpan_Years(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		_ = convertCustomUnits("1 year")
+		_, _ = defaultParser.parseSpan("1 year")
 	}
 }
 
-func BenchmarkConvertCustomUnits_Weekday(b *testing.B) {
+func BenchmarkParseSpan_Compound(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		_ = convertCustomUnits("last monday")
+		_, _ = defaultParser.parseSpan("1 day, 2 hours and 30 minutes")
 	}
 }
 
@@ -379,6 +379,69 @@ func TestParseTime_UnixTimestamps(t *testing.T) {
 	}
 }
 
+func TestParseTime_UnixTimestampUnits(t *testing.T) {
+	expected := time.Unix(1416434697, 123000000)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Time
+	}{
+		{name: "decimal seconds", input: "1416434697.123", expected: expected},
+		{name: "microseconds by magnitude", input: "1416434697123000", expected: expected},
+		{name: "nanoseconds by magnitude", input: "1416434697123000000", expected: expected},
+		{name: "decimal milliseconds", input: "1416434697123.5", expected: expected.Add(500 * time.Microsecond)},
+		{name: "negative decimal seconds", input: "-1.5", expected: time.Unix(-2, 500000000)},
+		{name: "marker", input: "@1416434697", expected: time.Unix(1416434697, 0)},
+		{name: "marker with decimal seconds", input: "@1416434697.123", expected: expected},
+		{name: "seconds suffix", input: "@1416434697s", expected: time.Unix(1416434697, 0)},
+		{name: "milliseconds suffix", input: "@1416434697123ms", expected: expected},
+		{name: "microseconds suffix", input: "@1416434697123000us", expected: expected},
+		{name: "micro sign suffix", input: "@1416434697123000µs", expected: expected},
+		{name: "nanoseconds suffix", input: "@1416434697123000000ns", expected: expected},
+		{name: "small value with explicit unit", input: "@1000ms", expected: time.Unix(1, 0)},
+		{name: "fraction past nanoseconds", input: "1416434697.1230000009", expected: expected},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseTime(tt.input, time.Now(), time.Time{})
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+
+	t.Run("a unit without the marker is a duration", func(t *testing.T) {
+		now := fixedTime()
+		result, err := ParseTime("30s", now, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(-30*time.Second), result)
+	})
+
+	for _, input := range []string{"@", "@abc", "@1416434697x", "@1.2.3"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := ParseTime(input, time.Now(), time.Time{})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParseTime_StrictTimestamps(t *testing.T) {
+	parser := NewParser(WithStrictTimestamps(true))
+
+	result, err := parser.ParseTime("@1416434697123ms", time.Now(), time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, time.UnixMilli(1416434697123), result)
+
+	for _, input := range []string{"1416434697", "1416434697.123", "@1416434697"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := parser.ParseTime(input, time.Now(), time.Time{})
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+}
+
 func TestParseTime_RelativeToStartTime(t *testing.T) {
 	now := fixedTime()
 	startTime := now.Add(-2 * time.Hour)
@@ -633,62 +696,6 @@ func TestParseTimeRange_EndBeforeStart(t *testing.T) {
 	assert.True(t, errors.Is(err, ErrEndBeforeStart), "Expected ErrEndBeforeStart")
 }
 
-func TestConvertCustomUnits(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    string
-		expected string
-	}{
-		{
-			name:     "seconds to s",
-			input:    "30 seconds",
-			expected: "30s",
-		},
-		{
-			name:     "minutes to m",
-			input:    "15 minutes",
-			expected: "15m",
-		},
-		{
-			name:     "hours to h",
-			input:    "2 hours",
-			expected: "2h",
-		},
-		{
-			name:     "days to hours",
-			input:    "3 days",
-			expected: "72h",
-		},
-		{
-			name:     "1 day to hours",
-			input:    "1 day",
-			expected: "24h",
-		},
-		{
-			name:     "months to hours",
-			input:    "2 months",
-			expected: "1440h",
-		},
-		{
-			name:     "years to hours",
-			input:    "1 year",
-			expected: "8760h",
-		},
-		{
-			name:     "preserve weekdays",
-			input:    "last monday",
-			expected: "last monday",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := convertCustomUnits(tt.input)
-			assert.Equal(t, tt.expected, result)
-		})
-	}
-}
-
 func TestGetMidnight(t *testing.T) {
 	tests := []struct {
 		name     string
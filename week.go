@@ -0,0 +1,226 @@
+package friendlytime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WeekNumbering selects how calendar weeks are numbered in expressions such
+// as "week 42" or "2025-W42".
+type WeekNumbering int
+
+const (
+	// ISOWeekNumbering numbers weeks as ISO 8601 does: weeks start on Monday
+	// and week 1 is the week containing January 4. With another week start,
+	// week 1 is still the first week with at least four days in the year.
+	ISOWeekNumbering WeekNumbering = iota
+	// USWeekNumbering numbers weeks as common in the US: weeks start on
+	// Sunday and week 1 is the week containing January 1. The days of that
+	// week that fall in December belong to week 1 of the new year.
+	USWeekNumbering
+)
+
+// isoFirstWeekDay is the day of January that ISO 8601 week 1 always contains.
+const isoFirstWeekDay = 4
+
+// mergeWeekFields joins "week 42", "calendar week 42" and "cw 42" into a
+// single "w42" token so they parse like "W42".
+func mergeWeekFields(fields []string) []string {
+	switch {
+	case len(fields) >= 3 && fields[0] == "calendar" && fields[1] == "week":
+		return append([]string{"w" + fields[2]}, fields[3:]...)
+	case len(fields) >= 2 && (fields[0] == "week" || fields[0] == "cw"):
+		return append([]string{"w" + fields[1]}, fields[2:]...)
+	case len(fields) >= 1 && strings.HasPrefix(fields[0], "cw"):
+		return append([]string{fields[0][1:]}, fields[1:]...)
+	}
+
+	return fields
+}
+
+// resolveWeek returns the numbered week of the given year as [start, end).
+func (p *Parser) resolveWeek(week periodPart, year int, loc *time.Location) (time.Time, time.Time, error) {
+	// Count the weeks in UTC so a DST change doesn't skew the day count.
+	firstUTC := p.firstWeekStart(year, time.UTC)
+	weeks := int(p.firstWeekStart(year+1, time.UTC).Sub(firstUTC).Hours()) / hoursPerDay / daysPerWeek
+
+	if week.value < 1 || week.value > weeks {
+		return time.Time{}, time.Time{}, fmt.Errorf(
+			"%w: %s is out of range, %d has %d weeks",
+			ErrInvalidTimeFormat,
+			strings.ToUpper(week.text),
+			year,
+			weeks,
+		)
+	}
+
+	start := p.firstWeekStart(year, loc).AddDate(0, 0, (week.value-1)*daysPerWeek)
+
+	return start, start.AddDate(0, 0, daysPerWeek), nil
+}
+
+// weekStart returns the first day of the week.
+func (p *Parser) weekStart() time.Weekday {
+	if p.weekStartSet {
+		return p.weekStartDay
+	}
+
+	if p.weekNumbering == USWeekNumbering {
+		return time.Sunday
+	}
+
+	return time.Monday
+}
+
+// startOfWeek returns midnight on the first day of the week containing t.
+func (p *Parser) startOfWeek(t time.Time) time.Time {
+	daysSinceStart := (int(t.Weekday()) - int(p.weekStart()) + daysPerWeek) % daysPerWeek
+
+	return getMidnight(t).AddDate(0, 0, -daysSinceStart)
+}
+
+// firstWeekStart returns the start of week 1 of the given year.
+func (p *Parser) firstWeekStart(year int, loc *time.Location) time.Time {
+	day := 1
+	if p.weekNumbering == ISOWeekNumbering {
+		day = isoFirstWeekDay
+	}
+
+	return p.startOfWeek(time.Date(year, time.January, day, 0, 0, 0, 0, loc))
+}
+
+// weekYear returns the year whose week numbering t falls in, which differs
+// from t's calendar year for the first and last days of some years.
+func (p *Parser) weekYear(t time.Time) int {
+	year := t.Year()
+
+	switch {
+	case !t.Before(p.firstWeekStart(year+1, t.Location())):
+		return year + 1
+	case t.Before(p.firstWeekStart(year, t.Location())):
+		return year - 1
+	default:
+		return year
+	}
+}
+
+// parseWeekdayOfWeek handles "<weekday> this|last|next week", optionally with
+// "of", such as "friday last week". The week is aligned to the week start, so
+// with weeks starting on Monday "sunday this week" is the coming Sunday.
+func (p *Parser) parseWeekdayOfWeek(timeStr string, now time.Time) (time.Time, bool) {
+	weekdayStr, weekStr, found := strings.Cut(timeStr, " ")
+	if !found {
+		return time.Time{}, false
+	}
+
+	weekday, err := parseWeekday(weekdayStr)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var offset int
+
+	switch strings.TrimPrefix(weekStr, "of ") {
+	case "this week":
+	case "last week":
+		offset = -1
+	case "next week":
+		offset = 1
+	default:
+		return time.Time{}, false
+	}
+
+	daysIntoWeek := (int(weekday) - int(p.weekStart()) + daysPerWeek) % daysPerWeek
+
+	return p.startOfWeek(now).AddDate(0, 0, offset*daysPerWeek+daysIntoWeek), true
+}
+
+// isWeekend reports whether day is one of the configured weekend days.
+func (p *Parser) isWeekend(day time.Weekday) bool {
+	return p.weekend[day]
+}
+
+// weekendDays returns how many days of the week are weekend days.
+func (p *Parser) weekendDays() int {
+	count := 0
+
+	for _, weekend := range p.weekend {
+		if weekend {
+			count++
+		}
+	}
+
+	return count
+}
+
+// weekendAround returns a weekend as [start, end): with offset 0 the weekend in
+// progress or, on a workday, the coming one; with a negative or positive
+// offset the weekends before or after it. A weekend is a run of consecutive
+// weekend days. It returns false when every day, or no day, is a weekend day.
+func (p *Parser) weekendAround(now time.Time, offset int) (time.Time, time.Time, bool) {
+	if days := p.weekendDays(); days == 0 || days == daysPerWeek {
+		return time.Time{}, time.Time{}, false
+	}
+
+	day := getMidnight(now)
+	for p.isWeekend(day.Weekday()) && p.isWeekend(day.AddDate(0, 0, -1).Weekday()) {
+		day = day.AddDate(0, 0, -1)
+	}
+
+	start, end := p.nextWeekend(day)
+
+	for ; offset > 0; offset-- {
+		start, end = p.nextWeekend(end)
+	}
+
+	for ; offset < 0; offset++ {
+		start, end = p.previousWeekend(start)
+	}
+
+	return start, end, true
+}
+
+// nextWeekend returns the first weekend starting on or after day.
+func (p *Parser) nextWeekend(day time.Time) (time.Time, time.Time) {
+	for !p.isWeekend(day.Weekday()) {
+		day = day.AddDate(0, 0, 1)
+	}
+
+	start := day
+	for p.isWeekend(day.Weekday()) {
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return start, day
+}
+
+// previousWeekend returns the last weekend ending on or before day.
+func (p *Parser) previousWeekend(day time.Time) (time.Time, time.Time) {
+	day = day.AddDate(0, 0, -1)
+	for !p.isWeekend(day.Weekday()) {
+		day = day.AddDate(0, 0, -1)
+	}
+
+	end := day.AddDate(0, 0, 1)
+	for p.isWeekend(day.AddDate(0, 0, -1).Weekday()) {
+		day = day.AddDate(0, 0, -1)
+	}
+
+	return day, end
+}
+
+// lastWorkday returns midnight on the most recent day before now that is not
+// a weekend day.
+func (p *Parser) lastWorkday(now time.Time) (time.Time, error) {
+	if p.weekendDays() == daysPerWeek {
+		return time.Time{}, fmt.Errorf("%w: every day is a weekend day", ErrInvalidWeekday)
+	}
+
+	day := getMidnight(now).AddDate(0, 0, -1)
+	for p.isWeekend(day.Weekday()) {
+		day = day.AddDate(0, 0, -1)
+	}
+
+	return day, nil
+}
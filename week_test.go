@@ -0,0 +1,214 @@
+package friendlytime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRange_Weeks(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	parser := NewParser(WithClock(fixedTime))
+
+	tests := []struct {
+		input string
+		start time.Time
+		end   time.Time
+	}{
+		{input: "week 42", start: midnight(2025, 10, 13), end: midnight(2025, 10, 20)},
+		{input: "W42", start: midnight(2025, 10, 13), end: midnight(2025, 10, 20)},
+		{input: "W42 2025", start: midnight(2025, 10, 13), end: midnight(2025, 10, 20)},
+		{input: "2025-W42", start: midnight(2025, 10, 13), end: midnight(2025, 10, 20)},
+		{input: "week 42 2024", start: midnight(2024, 10, 14), end: midnight(2024, 10, 21)},
+		{input: "calendar week 1 2026", start: midnight(2025, 12, 29), end: midnight(2026, 1, 5)},
+		{input: "CW 53 2020", start: midnight(2020, 12, 28), end: midnight(2021, 1, 4)},
+		{input: "this calendar week", start: midnight(2025, 12, 8), end: midnight(2025, 12, 15)},
+		{input: "last calendar week", start: midnight(2025, 12, 1), end: midnight(2025, 12, 8)},
+		{input: "next calendar week", start: midnight(2025, 12, 15), end: midnight(2025, 12, 22)},
+		{input: "W40/W42", start: midnight(2025, 9, 29), end: midnight(2025, 10, 20)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := parser.ParseRange(tt.input)
+			require.NoError(t, err)
+			assert.True(t, tt.start.Equal(r.Start), "expected start %v, got %v", tt.start, r.Start)
+			assert.True(t, tt.end.Equal(r.End), "expected end %v, got %v", tt.end, r.End)
+		})
+	}
+
+	for _, input := range []string{"2025-W53", "week 0", "W54 2020"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := parser.ParseRange(input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+		})
+	}
+
+	t.Run("last week keeps its meaning", func(t *testing.T) {
+		r, err := parser.ParseRange("last week")
+		require.NoError(t, err)
+		assert.Equal(t, midnight(2025, 12, 3), r.Start)
+	})
+
+	t.Run("week-numbering year differs from calendar year", func(t *testing.T) {
+		// Tuesday, December 30, 2025 is in ISO week 1 of 2026.
+		clock := func() time.Time { return time.Date(2025, 12, 30, 12, 0, 0, 0, time.UTC) }
+
+		r, err := NewParser(WithClock(clock)).ParseRange("week 1")
+		require.NoError(t, err)
+		assert.Equal(t, midnight(2025, 12, 29), r.Start)
+	})
+}
+
+func TestParser_WithWeekNumbering(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	parser := NewParser(WithClock(fixedTime), WithWeekNumbering(USWeekNumbering))
+
+	tests := []struct {
+		input string
+		start time.Time
+		end   time.Time
+	}{
+		{input: "week 1 2025", start: midnight(2024, 12, 29), end: midnight(2025, 1, 5)},
+		{input: "week 42 2025", start: midnight(2025, 10, 12), end: midnight(2025, 10, 19)},
+		{input: "this calendar week", start: midnight(2025, 12, 7), end: midnight(2025, 12, 14)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := parser.ParseRange(tt.input)
+			require.NoError(t, err)
+			assert.True(t, tt.start.Equal(r.Start), "expected start %v, got %v", tt.start, r.Start)
+			assert.True(t, tt.end.Equal(r.End), "expected end %v, got %v", tt.end, r.End)
+		})
+	}
+}
+
+func TestParser_WithWeekStart(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	mondayStart := NewParser()
+	sundayStart := NewParser(WithWeekStart(time.Sunday))
+
+	tests := []struct {
+		input  string
+		monday time.Time
+		sunday time.Time
+	}{
+		{input: "sunday this week", monday: midnight(2025, 12, 14), sunday: midnight(2025, 12, 7)},
+		{input: "monday this week", monday: midnight(2025, 12, 8), sunday: midnight(2025, 12, 8)},
+		{input: "friday last week", monday: midnight(2025, 12, 5), sunday: midnight(2025, 12, 5)},
+		{input: "sunday of next week", monday: midnight(2025, 12, 21), sunday: midnight(2025, 12, 14)},
+		{input: "this calendar week", monday: midnight(2025, 12, 8), sunday: midnight(2025, 12, 7)},
+		{input: "week 1 2025", monday: midnight(2024, 12, 30), sunday: midnight(2024, 12, 29)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := mondayStart.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.monday, result)
+
+			result, err = sundayStart.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.sunday, result)
+		})
+	}
+}
+
+func TestParser_WithWeekStartLastWeekday(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	now := fixedTime()
+	previous := WithLastWeekdayInPreviousWeek(true)
+	us := WithWeekNumbering(USWeekNumbering)
+
+	tests := []struct {
+		input    string
+		parser   *Parser
+		expected time.Time
+	}{
+		{input: "last monday", parser: NewParser(), expected: midnight(2025, 12, 8)},
+		{input: "last monday", parser: NewParser(WithWeekStart(time.Monday)), expected: midnight(2025, 12, 8)},
+		{input: "last monday", parser: NewParser(previous), expected: midnight(2025, 12, 1)},
+		{input: "last monday", parser: NewParser(previous, WithWeekStart(time.Monday)), expected: midnight(2025, 12, 1)},
+		{input: "last monday", parser: NewParser(previous, WithWeekStart(time.Thursday)), expected: midnight(2025, 12, 1)},
+		{input: "last wednesday", parser: NewParser(previous, WithWeekStart(time.Thursday)), expected: midnight(2025, 12, 3)},
+		{input: "last thursday", parser: NewParser(previous, WithWeekStart(time.Thursday)), expected: midnight(2025, 11, 27)},
+		{input: "last sunday", parser: NewParser(previous, WithWeekStart(time.Sunday)), expected: midnight(2025, 11, 30)},
+		{input: "last saturday", parser: NewParser(previous, WithWeekStart(time.Sunday)), expected: midnight(2025, 12, 6)},
+		{input: "last sunday", parser: NewParser(previous, us), expected: midnight(2025, 11, 30)},
+		{input: "last tuesday", parser: NewParser(previous, us), expected: midnight(2025, 12, 2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := tt.parser.ParseTime(tt.input, now, time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestParser_WithWeekend(t *testing.T) {
+	// Wednesday, December 10, 2025, 15:30:45
+	saturday := func() time.Time { return time.Date(2025, 12, 13, 12, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name   string
+		parser *Parser
+		input  string
+		start  time.Time
+		end    time.Time
+	}{
+		{name: "this weekend", parser: NewParser(WithClock(fixedTime)), input: "this weekend", start: midnight(2025, 12, 13), end: midnight(2025, 12, 15)},
+		{name: "last weekend", parser: NewParser(WithClock(fixedTime)), input: "last weekend", start: midnight(2025, 12, 6), end: midnight(2025, 12, 8)},
+		{name: "next weekend", parser: NewParser(WithClock(fixedTime)), input: "next weekend", start: midnight(2025, 12, 20), end: midnight(2025, 12, 22)},
+		{name: "this weekend on saturday", parser: NewParser(WithClock(saturday)), input: "this weekend", start: midnight(2025, 12, 13), end: midnight(2025, 12, 15)},
+		{name: "last weekend on saturday", parser: NewParser(WithClock(saturday)), input: "last weekend", start: midnight(2025, 12, 6), end: midnight(2025, 12, 8)},
+		{
+			name:   "friday and saturday",
+			parser: NewParser(WithClock(fixedTime), WithWeekend(time.Friday, time.Saturday)),
+			input:  "this weekend",
+			start:  midnight(2025, 12, 12),
+			end:    midnight(2025, 12, 14),
+		},
+		{
+			name:   "friday and saturday on saturday",
+			parser: NewParser(WithClock(saturday), WithWeekend(time.Friday, time.Saturday)),
+			input:  "last weekend",
+			start:  midnight(2025, 12, 5),
+			end:    midnight(2025, 12, 7),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := tt.parser.ParseRange(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.start, r.Start)
+			assert.Equal(t, tt.end, r.End)
+		})
+	}
+
+	t.Run("last business day", func(t *testing.T) {
+		monday := time.Date(2025, 12, 15, 9, 0, 0, 0, time.UTC)
+		sunday := time.Date(2025, 12, 14, 9, 0, 0, 0, time.UTC)
+
+		result, err := NewParser().ParseTime("last business day", monday, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, midnight(2025, 12, 12), result)
+
+		result, err = NewParser(WithWeekend(time.Friday, time.Saturday)).ParseTime("last weekday", sunday, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, midnight(2025, 12, 11), result)
+	})
+
+	t.Run("no weekend", func(t *testing.T) {
+		_, err := NewParser(WithWeekend()).ParseTime("last weekend", fixedTime(), time.Time{})
+		require.Error(t, err)
+	})
+}